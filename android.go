@@ -0,0 +1,327 @@
+package i18n
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// FormatAndroid is an Android `strings.xml` resource file.
+const FormatAndroid Format = "android"
+
+// Decoder of Android `strings.xml` resource files. `<string>` elements are
+// converted to messages, `<plurals>` elements to plural rules. Positional
+// format specifiers `%1$s` are converted to `%[1]s`. A comment before an
+// element is used as the message description.
+type androidDecoder struct{}
+
+// Decode translation messages.
+func (d androidDecoder) Decode(data []byte) ([]Message, error) {
+	return publicMessages(d.decode(data))
+}
+
+// Android plural item.
+type androidItem struct {
+	Quantity string `xml:"quantity,attr"`
+	Text     string `xml:",innerxml"`
+}
+
+// Android string element.
+type androidString struct {
+	Name string `xml:"name,attr"`
+	Text string `xml:",innerxml"`
+}
+
+// Android plurals element.
+type androidPlurals struct {
+	Name  string        `xml:"name,attr"`
+	Items []androidItem `xml:"item"`
+}
+
+// Decode translation messages.
+func (androidDecoder) decode(data []byte) ([]translationMessage, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+
+	var result []translationMessage
+	var comment string
+
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		switch token := token.(type) {
+		case xml.Comment:
+			comment = strings.TrimSpace(string(token))
+		case xml.StartElement:
+			m, ok, err := decodeAndroidElement(decoder, token)
+			if err != nil {
+				return nil, err
+			}
+
+			if ok {
+				m.Description = comment
+				result = append(result, m)
+			}
+
+			comment = ""
+		}
+	}
+
+	sort.Slice(result, func(a, b int) bool { return result[a].ID < result[b].ID })
+
+	return result, nil
+}
+
+// Decode a `<string>` or `<plurals>` element.
+func decodeAndroidElement(
+	decoder *xml.Decoder,
+	start xml.StartElement,
+) (translationMessage, bool, error) {
+	switch start.Name.Local {
+	case "string":
+		var element androidString
+		if err := decoder.DecodeElement(&element, &start); err != nil {
+			return translationMessage{}, false, err
+		}
+
+		text := androidToPrintf(element.Text)
+
+		return translationMessage{ID: element.Name, Message: &text}, true, nil
+	case "plurals":
+		var element androidPlurals
+		if err := decoder.DecodeElement(&element, &start); err != nil {
+			return translationMessage{}, false, err
+		}
+
+		rules := make(map[string]interface{}, len(element.Items))
+		for _, item := range element.Items {
+			rules[item.Quantity] = androidToPrintf(item.Text)
+		}
+
+		return translationMessage{ID: element.Name, Rules: rules}, true, nil
+	default:
+		return translationMessage{}, false, nil
+	}
+}
+
+// Android positional format specifier.
+var androidVerbRegexp = regexp.MustCompile(`%(\d+)\$`)
+
+// Convert an Android string to a format string: decode entities, unquote,
+// unescape and convert positional format specifiers. Markup is kept as is.
+func androidToPrintf(s string) string {
+	s = strings.TrimSpace(decodeInnerXML(s))
+
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+		s = s[1 : len(s)-1]
+	}
+
+	s = strings.NewReplacer(
+		`\'`, `'`,
+		`\"`, `"`,
+		`\n`, "\n",
+		`\t`, "\t",
+		`\@`, "@",
+		`\?`, "?",
+		`\\`, `\`,
+	).Replace(s)
+
+	return androidVerbRegexp.ReplaceAllString(s, "%[$1]")
+}
+
+// Decode entities of inner XML, elements are kept as is.
+func decodeInnerXML(s string) string {
+	decoder := xml.NewDecoder(strings.NewReader("<root>" + s + "</root>"))
+
+	var b strings.Builder
+
+	depth := 0
+
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			break
+		}
+
+		switch token := token.(type) {
+		case xml.CharData:
+			b.Write(token)
+		case xml.StartElement:
+			if depth++; depth == 1 {
+				continue
+			}
+
+			b.WriteString("<" + token.Name.Local)
+
+			for _, attr := range token.Attr {
+				fmt.Fprintf(&b, " %v=\"%v\"", attr.Name.Local, escapeXML(attr.Value))
+			}
+
+			b.WriteString(">")
+		case xml.EndElement:
+			if depth--; depth == 0 {
+				continue
+			}
+
+			b.WriteString("</" + token.Name.Local + ">")
+		}
+	}
+
+	return b.String()
+}
+
+// Convert a format string to an Android string, markup is kept as is and
+// only texts between elements are escaped.
+func printfToAndroid(s string) string {
+	s = replaceVerbs(s, func(v verb) string {
+		if v.arg == 0 {
+			return "%%"
+		}
+
+		char := v.char
+		if char == 'v' {
+			char = 's'
+		}
+
+		return fmt.Sprintf("%%%v$%v%v%c", v.arg, v.flags, v.width, char)
+	})
+
+	var b strings.Builder
+
+	for n := 0; n < len(s); {
+		if s[n] == '<' && isTagStart(s[n+1:]) {
+			if end := strings.IndexByte(s[n:], '>'); end >= 0 {
+				b.WriteString(s[n : n+end+1])
+				n += end + 1
+
+				continue
+			}
+		}
+
+		next := n + 1
+		for next < len(s) && (s[next] != '<' || !isTagStart(s[next+1:])) {
+			next++
+		}
+
+		b.WriteString(escapeAndroidText(s[n:next]))
+		n = next
+	}
+
+	s = b.String()
+
+	if strings.HasPrefix(s, "@") || strings.HasPrefix(s, "?") {
+		s = `\` + s
+	}
+
+	return s
+}
+
+// Escape a text between elements of an Android string.
+func escapeAndroidText(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		`'`, `\'`,
+		`"`, `\"`,
+		"\n", `\n`,
+		"\t", `\t`,
+		"&", "&amp;",
+		"<", "&lt;",
+	).Replace(s)
+}
+
+// Encode messages as Android `strings.xml` resource file. Plural selectors
+// `=N` are not supported by Android and skipped, format verbs are written as
+// positional format specifiers. A message with several plural arguments or
+// with `<N` and `>N` selectors can't be exported.
+func encodeAndroid(w io.Writer, _ language.Tag, m []translationMessage) error {
+	var b bytes.Buffer
+
+	b.WriteString(`<?xml version="1.0" encoding="utf-8"?>` + "\n<resources>\n")
+
+	for _, message := range m {
+		if message.Description != "" {
+			fmt.Fprintf(&b, "    <!-- %v -->\n", strings.ReplaceAll(message.Description, "--", "- -"))
+		}
+
+		name := escapeXML(message.ID)
+
+		if message.Message != nil {
+			fmt.Fprintf(&b, "    <string name=\"%v\">%v</string>\n", name, printfToAndroid(*message.Message))
+			continue
+		}
+
+		arguments := pluralArguments(message.Rules)
+
+		switch {
+		case len(arguments) > 1:
+			return &ErrorEncode{Format: FormatAndroid, MessageID: message.ID, Message: "several plural arguments"}
+		case len(arguments) == 0:
+			continue
+		}
+
+		if text, ok := comparisonError(arguments[0]); ok {
+			return &ErrorEncode{Format: FormatAndroid, MessageID: message.ID, Message: text}
+		}
+
+		fmt.Fprintf(&b, "    <plurals name=\"%v\">\n", name)
+
+		for _, selector := range arguments[0].selectors {
+			if !isCategory(selector) {
+				continue
+			}
+
+			fmt.Fprintf(
+				&b,
+				"        <item quantity=\"%v\">%v</item>\n",
+				selector,
+				printfToAndroid(arguments[0].messages[selector]),
+			)
+		}
+
+		b.WriteString("    </plurals>\n")
+	}
+
+	b.WriteString("</resources>\n")
+
+	_, err := w.Write(b.Bytes())
+
+	return err
+}
+
+// Check if the text after `<` starts an element.
+func isTagStart(s string) bool {
+	s = strings.TrimPrefix(s, "/")
+
+	return len(s) > 0 && (s[0] >= 'a' && s[0] <= 'z' || s[0] >= 'A' && s[0] <= 'Z')
+}
+
+// Escape a text for XML.
+func escapeXML(s string) string {
+	var b strings.Builder
+
+	_ = xml.EscapeText(&b, []byte(s))
+
+	return b.String()
+}
+
+// Check if the selector is a plural category.
+func isCategory(selector string) bool {
+	switch selector {
+	case "zero", "one", "two", "few", "many", "other":
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,104 @@
+package i18n
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+)
+
+func Test_AndroidDecode(t *testing.T) {
+	t.Parallel()
+
+	text := func(s string) *string { return &s }
+
+	data := `<?xml version="1.0" encoding="utf-8"?>
+<resources>
+    <!-- Greeting -->
+    <string name="hello">Hello, %1$s! You\'re <b>welcome</b> &amp; more&#8230;</string>
+    <string name="quoted">"  spaces  "</string>
+    <string name="percent">100%%</string>
+    <plurals name="apples">
+        <item quantity="one">%d apple</item>
+        <item quantity="other">%d apples</item>
+    </plurals>
+    <string-array name="planets">
+        <item>Mercury</item>
+    </string-array>
+</resources>
+`
+
+	m, err := androidDecoder{}.decode([]byte(data))
+	assert.NoError(t, err)
+
+	assert.Equal(t, []translationMessage{
+		{ID: "apples", Rules: map[string]interface{}{"one": "%d apple", "other": "%d apples"}},
+		{ID: "hello", Message: text("Hello, %[1]s! You're <b>welcome</b> & more…"), Description: "Greeting"},
+		{ID: "percent", Message: text("100%%")},
+		{ID: "quoted", Message: text("  spaces  ")},
+	}, m)
+
+	_, err = androidDecoder{}.decode([]byte(`<resources><string name="broken">`))
+	assert.Error(t, err)
+}
+
+func Test_AndroidEncode(t *testing.T) {
+	t.Parallel()
+
+	text := "Hello, %[1]v! You're <b>welcome</b> & 1 < 2, 100%%"
+	price := "Price: %.2f"
+	at := "@home"
+	link := `Read <a href="https://example.com">"docs"</a>`
+
+	m := []translationMessage{
+		{
+			ID:    "apples",
+			Rules: map[string]interface{}{"one": "%d apple", "other": "%d apples", "=0": "no apples"},
+		},
+		{ID: "at", Message: &at},
+		{ID: "hello", Message: &text, Description: "Greeting"},
+		{ID: "link", Message: &link},
+		{ID: "price", Message: &price},
+	}
+
+	var out bytes.Buffer
+
+	assert.NoError(t, encodeAndroid(&out, language.English, m))
+	assert.Equal(t, `<?xml version="1.0" encoding="utf-8"?>
+<resources>
+    <plurals name="apples">
+        <item quantity="one">%1$d apple</item>
+        <item quantity="other">%1$d apples</item>
+    </plurals>
+    <string name="at">\@home</string>
+    <!-- Greeting -->
+    <string name="hello">Hello, %1$s! You\'re <b>welcome</b> &amp; 1 &lt; 2, 100%%</string>
+    <string name="link">Read <a href="https://example.com">\"docs\"</a></string>
+    <string name="price">Price: %1$.2f</string>
+</resources>
+`, out.String())
+
+	decoded, err := androidDecoder{}.decode(out.Bytes())
+	assert.NoError(t, err)
+
+	assert.Equal(t, "@home", *decoded[1].Message)
+	assert.Equal(t, "Hello, %[1]s! You're <b>welcome</b> & 1 < 2, 100%%", *decoded[2].Message)
+	assert.Equal(t, link, *decoded[3].Message)
+	assert.Equal(t, "Price: %[1].2f", *decoded[4].Message)
+
+	err = encodeAndroid(&out, language.English, []translationMessage{{
+		ID: "files",
+		Rules: map[string]interface{}{
+			"1": map[string]interface{}{"one": "%d folder", "other": "%d folders"},
+			"2": map[string]interface{}{"one": "%d file", "other": "%d files"},
+		},
+	}})
+	assert.IsType(t, &ErrorEncode{}, err)
+
+	err = encodeAndroid(&out, language.English, []translationMessage{{
+		ID:    "few apples",
+		Rules: map[string]interface{}{"<5": "a few apples", "other": "%d apples"},
+	}})
+	assert.IsType(t, &ErrorEncode{}, err)
+}
@@ -0,0 +1,777 @@
+package i18n
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+
+	"golang.org/x/text/encoding/unicode"
+	"golang.org/x/text/language"
+	"golang.org/x/text/transform"
+)
+
+// Apple export formats.
+const (
+	// FormatStrings is an Apple `.strings` file.
+	FormatStrings Format = "strings"
+	// FormatStringsdict is an Apple `.stringsdict` plural dictionary.
+	FormatStringsdict Format = "stringsdict"
+)
+
+// Decoder of Apple `.strings` files. Format specifiers `%@` and `%1$ld` are
+// converted to `%v` and `%[1]d`. A comment before an entry is used as the
+// message description.
+type stringsDecoder struct{}
+
+// Decode translation messages.
+func (d stringsDecoder) Decode(data []byte) ([]Message, error) {
+	return publicMessages(d.decode(data))
+}
+
+// Decode translation messages.
+func (stringsDecoder) decode(data []byte) ([]translationMessage, error) {
+	data, err := decodeBOM(data)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &stringsParser{s: string(data), line: 1}
+
+	var result []translationMessage
+
+	for {
+		comment, err := p.skip()
+		if err != nil {
+			return nil, err
+		}
+
+		if p.pos >= len(p.s) {
+			break
+		}
+
+		key, err := p.string()
+		if err != nil {
+			return nil, err
+		}
+
+		value := key
+
+		if _, err = p.skip(); err != nil {
+			return nil, err
+		}
+
+		if p.pos < len(p.s) && p.s[p.pos] == '=' {
+			p.pos++
+
+			if _, err = p.skip(); err != nil {
+				return nil, err
+			}
+
+			if value, err = p.string(); err != nil {
+				return nil, err
+			}
+
+			if _, err = p.skip(); err != nil {
+				return nil, err
+			}
+		}
+
+		if err = p.expect(';'); err != nil {
+			return nil, err
+		}
+
+		// Default comment of genstrings.
+		if comment == "No comment provided by engineer." {
+			comment = ""
+		}
+
+		text := appleToPrintf(value)
+		result = append(result, translationMessage{ID: key, Message: &text, Description: comment})
+	}
+
+	return result, nil
+}
+
+// Decode UTF-16 and UTF-8 with a byte order mark to UTF-8.
+func decodeBOM(data []byte) ([]byte, error) {
+	data, _, err := transform.Bytes(unicode.BOMOverride(transform.Nop), data)
+
+	return data, err
+}
+
+// Parser of Apple `.strings` files.
+type stringsParser struct {
+	s    string
+	pos  int
+	line int
+}
+
+// Skip spaces and comments, returns the last comment.
+func (p *stringsParser) skip() (string, error) {
+	var comment string
+
+	for p.pos < len(p.s) {
+		switch {
+		case p.s[p.pos] == '\n':
+			p.line++
+			p.pos++
+		case strings.IndexByte(" \t\r", p.s[p.pos]) >= 0:
+			p.pos++
+		case strings.HasPrefix(p.s[p.pos:], "/*"):
+			end := strings.Index(p.s[p.pos+2:], "*/")
+			if end < 0 {
+				return "", p.error("unclosed comment")
+			}
+
+			comment = strings.TrimSpace(p.s[p.pos+2 : p.pos+2+end])
+			p.line += strings.Count(comment, "\n")
+			p.pos += end + 4
+		case strings.HasPrefix(p.s[p.pos:], "//"):
+			end := strings.IndexByte(p.s[p.pos:], '\n')
+			if end < 0 {
+				end = len(p.s) - p.pos
+			}
+
+			comment = strings.TrimSpace(p.s[p.pos+2 : p.pos+end])
+			p.pos += end
+		default:
+			return comment, nil
+		}
+	}
+
+	return comment, nil
+}
+
+// Read a quoted or unquoted string.
+func (p *stringsParser) string() (string, error) {
+	if p.pos < len(p.s) && p.s[p.pos] == '"' {
+		return p.quoted()
+	}
+
+	start := p.pos
+
+	for p.pos < len(p.s) && isUnquoted(p.s[p.pos]) {
+		p.pos++
+	}
+
+	if p.pos == start {
+		return "", p.error("string expected")
+	}
+
+	return p.s[start:p.pos], nil
+}
+
+// Check if the character is allowed in an unquoted string.
+func isUnquoted(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' ||
+		strings.IndexByte("_.$:/-", c) >= 0
+}
+
+// Read a quoted string with escape sequences.
+func (p *stringsParser) quoted() (string, error) {
+	var b strings.Builder
+
+	for p.pos++; p.pos < len(p.s); p.pos++ {
+		c := p.s[p.pos]
+
+		switch c {
+		case '"':
+			p.pos++
+
+			return b.String(), nil
+		case '\n':
+			p.line++
+		case '\\':
+			if p.pos++; p.pos >= len(p.s) {
+				break
+			}
+
+			r, ok := p.unicode()
+			if ok {
+				b.WriteRune(r)
+				continue
+			}
+
+			switch c = p.s[p.pos]; c {
+			case 'n':
+				b.WriteByte('\n')
+			case 't':
+				b.WriteByte('\t')
+			case 'r':
+				b.WriteByte('\r')
+			default:
+				b.WriteByte(c)
+			}
+
+			continue
+		}
+
+		b.WriteByte(c)
+	}
+
+	return "", p.error("unclosed string")
+}
+
+// Read a `\Uxxxx` escape sequence starting after the backslash, surrogate
+// pairs are combined.
+func (p *stringsParser) unicode() (rune, bool) {
+	r, ok := p.hex(p.pos)
+	if !ok {
+		return 0, false
+	}
+
+	p.pos += 4
+
+	if utf16.IsSurrogate(r) && strings.HasPrefix(p.s[p.pos+1:], `\`) {
+		if low, ok := p.hex(p.pos + 2); ok {
+			r = utf16.DecodeRune(r, low)
+			p.pos += 6
+		}
+	}
+
+	return r, true
+}
+
+// Parse a `Uxxxx` code unit at the position.
+func (p *stringsParser) hex(pos int) (rune, bool) {
+	if pos+5 > len(p.s) || (p.s[pos] != 'U' && p.s[pos] != 'u') {
+		return 0, false
+	}
+
+	n, err := strconv.ParseUint(p.s[pos+1:pos+5], 16, 16)
+	if err != nil {
+		return 0, false
+	}
+
+	return rune(n), true
+}
+
+// Skip the expected character.
+func (p *stringsParser) expect(c byte) error {
+	if p.pos >= len(p.s) || p.s[p.pos] != c {
+		return p.error(fmt.Sprintf("`%c` expected", c))
+	}
+
+	p.pos++
+
+	return nil
+}
+
+// Create a decode error at the current line.
+func (p *stringsParser) error(message string) error {
+	return &ErrorDecode{Format: "strings", Message: fmt.Sprintf("line %v: %v", p.line, message)}
+}
+
+// Encode messages as Apple `.strings` file. Messages with plural rules are
+// skipped, export them as `.stringsdict`.
+func encodeStrings(w io.Writer, _ language.Tag, m []translationMessage) error {
+	var b bytes.Buffer
+
+	for _, message := range m {
+		if message.Message == nil {
+			continue
+		}
+
+		if b.Len() > 0 {
+			b.WriteString("\n")
+		}
+
+		if message.Description != "" {
+			fmt.Fprintf(&b, "/* %v */\n", strings.ReplaceAll(message.Description, "*/", "* /"))
+		}
+
+		fmt.Fprintf(&b, "%v = %v;\n", quotePO(message.ID), quotePO(printfToApple(*message.Message, 0)))
+	}
+
+	_, err := w.Write(b.Bytes())
+
+	return err
+}
+
+// Decoder of Apple `.stringsdict` plural dictionaries. The format string of
+// an entry may contain one plural variable `%#@name@`, a text around it is
+// added to every plural form. The `zero` form is converted to the `=0`
+// selector as Apple uses it for zero in every language.
+type stringsdictDecoder struct{}
+
+// Decode translation messages.
+func (d stringsdictDecoder) Decode(data []byte) ([]Message, error) {
+	return publicMessages(d.decode(data))
+}
+
+// Decode translation messages.
+func (stringsdictDecoder) decode(data []byte) ([]translationMessage, error) {
+	v, err := decodePlist(data)
+	if err != nil {
+		return nil, err
+	}
+
+	root, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, &ErrorDecode{Format: "stringsdict", Message: "root should be a dictionary"}
+	}
+
+	keys := make([]string, 0, len(root))
+	for key := range root {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	result := make([]translationMessage, 0, len(keys))
+
+	for _, key := range keys {
+		m, err := stringsdictMessage(root[key])
+		if err != nil {
+			return nil, &ErrorDecode{Format: "stringsdict", Message: fmt.Sprintf("message `%v`: %v", key, err)}
+		}
+
+		m.ID = key
+		result = append(result, m)
+	}
+
+	return result, nil
+}
+
+// Convert a stringsdict entry to a message.
+func stringsdictMessage(v interface{}) (translationMessage, error) {
+	var m translationMessage
+
+	entry, ok := v.(map[string]interface{})
+	if !ok {
+		return m, fmt.Errorf("entry should be a dictionary")
+	}
+
+	format, ok := entry["NSStringLocalizedFormatKey"].(string)
+	if !ok {
+		return m, fmt.Errorf("`NSStringLocalizedFormatKey` should be a string")
+	}
+
+	variable, ok, err := splitAppleVariable(format)
+	if err != nil {
+		return m, err
+	}
+
+	if !ok {
+		text := appleToPrintf(format)
+		m.Message = &text
+
+		return m, nil
+	}
+
+	spec, ok := entry[variable.name].(map[string]interface{})
+	if !ok {
+		return m, fmt.Errorf("variable `%v` is not defined", variable.name)
+	}
+
+	if spec["NSStringFormatSpecTypeKey"] != "NSStringPluralRuleType" {
+		return m, fmt.Errorf("variable `%v` should be of `NSStringPluralRuleType` type", variable.name)
+	}
+
+	forms := make(map[string]string)
+
+	for key, value := range spec {
+		if text, ok := value.(string); ok && isCategory(key) {
+			forms[key] = text
+		}
+	}
+
+	m.Rules, err = variable.rules(forms)
+
+	return m, err
+}
+
+// Decode an XML property list to dictionaries, arrays, strings and booleans.
+// Numbers and dates are decoded as strings.
+func decodePlist(data []byte) (interface{}, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return nil, &ErrorDecode{Format: "plist", Message: "value not found"}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if start, ok := token.(xml.StartElement); ok && start.Name.Local != "plist" {
+			return decodePlistValue(decoder, start)
+		}
+	}
+}
+
+// Decode a property list value of the element.
+func decodePlistValue(decoder *xml.Decoder, start xml.StartElement) (interface{}, error) {
+	switch start.Name.Local {
+	case "dict":
+		result := make(map[string]interface{})
+
+		var key string
+
+		err := decodePlistItems(decoder, func(start xml.StartElement) error {
+			if start.Name.Local == "key" {
+				return decoder.DecodeElement(&key, &start)
+			}
+
+			value, err := decodePlistValue(decoder, start)
+			result[key] = value
+
+			return err
+		})
+
+		return result, err
+	case "array":
+		result := make([]interface{}, 0)
+
+		err := decodePlistItems(decoder, func(start xml.StartElement) error {
+			value, err := decodePlistValue(decoder, start)
+			result = append(result, value)
+
+			return err
+		})
+
+		return result, err
+	case "true", "false":
+		return start.Name.Local == "true", decoder.Skip()
+	default:
+		var s string
+		err := decoder.DecodeElement(&s, &start)
+
+		return s, err
+	}
+}
+
+// Decode child elements until the end of the parent element.
+func decodePlistItems(decoder *xml.Decoder, item func(start xml.StartElement) error) error {
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+
+		switch token := token.(type) {
+		case xml.StartElement:
+			if err = item(token); err != nil {
+				return err
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// Encode messages as Apple `.stringsdict` plural dictionary. Messages
+// without plural rules are skipped, export them as `.strings`. Only the
+// first plural argument is written, `=N` selectors other than `=0` are
+// skipped. A message with `<N` and `>N` selectors can't be exported.
+func encodeStringsdict(w io.Writer, _ language.Tag, m []translationMessage) error {
+	var b bytes.Buffer
+
+	b.WriteString(`<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+`)
+
+	for _, message := range m {
+		arguments := pluralArguments(message.Rules)
+		if message.Message != nil || len(arguments) == 0 {
+			continue
+		}
+
+		arg := arguments[0]
+		if text, ok := comparisonError(arg); ok {
+			return &ErrorEncode{Format: FormatStringsdict, MessageID: message.ID, Message: text}
+		}
+
+		format := "%#@value@"
+		if arg.number != 1 {
+			format = fmt.Sprintf("%%%v$#@value@", arg.number)
+		}
+
+		fmt.Fprintf(&b, "\t<key>%v</key>\n\t<dict>\n", escapeXML(message.ID))
+		fmt.Fprintf(&b, "\t\t<key>NSStringLocalizedFormatKey</key>\n\t\t<string>%v</string>\n", format)
+		b.WriteString("\t\t<key>value</key>\n\t\t<dict>\n")
+		b.WriteString("\t\t\t<key>NSStringFormatSpecTypeKey</key>\n\t\t\t<string>NSStringPluralRuleType</string>\n")
+		fmt.Fprintf(
+			&b,
+			"\t\t\t<key>NSStringFormatValueTypeKey</key>\n\t\t\t<string>%v</string>\n",
+			appleValueType(arg),
+		)
+
+		forms := appleForms(arg)
+
+		for _, category := range []string{"zero", "one", "two", "few", "many", "other"} {
+			if text, ok := forms[category]; ok {
+				fmt.Fprintf(
+					&b,
+					"\t\t\t<key>%v</key>\n\t\t\t<string>%v</string>\n",
+					category,
+					escapeXML(printfToApple(text, 0)),
+				)
+			}
+		}
+
+		b.WriteString("\t\t</dict>\n\t</dict>\n")
+	}
+
+	b.WriteString("</dict>\n</plist>\n")
+
+	_, err := w.Write(b.Bytes())
+
+	return err
+}
+
+// Format specifier of Apple strings, e.g. `%@`, `%1$ld` or a plural
+// variable `%#@count@`.
+var appleVerbRegexp = regexp.MustCompile(
+	`%(?:(\d+)\$)?(?:#@(\w*)@|([-+ #0']*)(\d*(?:\.\d+)?)(?:hh|h|ll|l|q|z|t|j|L)?([@dDiuUxXoOfFeEgGaAcCsSp%]))`,
+)
+
+// Apple format specifier with its argument number.
+type appleVerb struct {
+	// Argument number starting from 1, 0 for `%%`.
+	arg int
+	// Name of a plural variable `%#@name@`.
+	variable string
+	// Flags, width and precision.
+	flags, width string
+	// Verb character of the fmt package.
+	char byte
+}
+
+// Write the specifier as a format verb, optionally with an argument index.
+func (v appleVerb) printf(index bool) string {
+	if v.arg == 0 {
+		return "%%"
+	}
+
+	s := "%" + v.flags
+	if index {
+		s += "[" + strconv.Itoa(v.arg) + "]"
+	}
+
+	return s + v.width + string(v.char)
+}
+
+// Replace format specifiers of the Apple string. Arguments without a
+// position are numbered in sequence starting from first, a position resets
+// the sequence.
+func replaceAppleVerbs(s string, first int, replace func(v appleVerb) string) string {
+	next := first
+
+	return appleVerbRegexp.ReplaceAllStringFunc(s, func(match string) string {
+		sub := appleVerbRegexp.FindStringSubmatch(match)
+
+		if sub[5] == "%" {
+			return replace(appleVerb{char: '%'})
+		}
+
+		v := appleVerb{
+			variable: sub[2],
+			flags:    strings.ReplaceAll(sub[3], "'", ""),
+			width:    sub[4],
+			char:     'v',
+		}
+
+		if sub[5] != "" {
+			v.char = goVerb(sub[5][0])
+		}
+
+		if sub[1] != "" {
+			next, _ = strconv.Atoi(sub[1])
+		}
+
+		v.arg = next
+		next++
+
+		return replace(v)
+	})
+}
+
+// Convert an Apple format verb character to a fmt package one.
+func goVerb(c byte) byte {
+	switch c {
+	case '@':
+		return 'v'
+	case 'D', 'i', 'u', 'U':
+		return 'd'
+	case 'O':
+		return 'o'
+	case 'F':
+		return 'f'
+	case 'a':
+		return 'x'
+	case 'A':
+		return 'X'
+	case 'C':
+		return 'c'
+	case 'S':
+		return 's'
+	}
+
+	return c
+}
+
+// Convert an Apple string to a format string. Arguments are indexed only if
+// the position differs from the fmt package sequence.
+func appleToPrintf(s string) string {
+	seq := 1
+
+	return replaceAppleVerbs(s, 1, func(v appleVerb) string {
+		if v.arg == 0 {
+			return v.printf(false)
+		}
+
+		index := v.arg != seq
+		seq = v.arg + 1
+
+		return v.printf(index)
+	})
+}
+
+// Convert a format string to an Apple string with positional specifiers.
+// The count argument without flags, width and precision is written as `%arg`
+// of a String Catalog substitution.
+func printfToApple(s string, count int) string {
+	return replaceVerbs(s, func(v verb) string {
+		if v.arg == 0 {
+			return "%%"
+		}
+
+		if v.arg == count && v.flags == "" && v.width == "" {
+			return "%arg"
+		}
+
+		return fmt.Sprintf("%%%v$%v%v%v", v.arg, v.flags, v.width, appleSpecifier(v.char))
+	})
+}
+
+// Convert a fmt package verb character to an Apple specifier.
+func appleSpecifier(c byte) string {
+	switch c {
+	case 'v', 's', 'q', 't':
+		return "@"
+	case 'd', 'x', 'X', 'o':
+		return "ll" + string(c)
+	}
+
+	return string(c)
+}
+
+// Apple format string with a plural variable.
+type appleVariable struct {
+	name string
+	// Argument number of the variable.
+	arg int
+	// Format strings around the variable.
+	prefix, suffix string
+}
+
+// Find a plural variable `%#@name@` of the Apple format string, only one
+// variable is supported. It isn't ok if the string has no variables.
+func splitAppleVariable(format string) (appleVariable, bool, error) {
+	var v appleVariable
+	var err error
+
+	text := replaceAppleVerbs(format, 1, func(verb appleVerb) string {
+		if verb.variable == "" {
+			return verb.printf(verb.arg != 0)
+		}
+
+		if v.name != "" {
+			err = fmt.Errorf("only one plural variable is supported")
+		}
+
+		v.name, v.arg = verb.variable, verb.arg
+
+		return "\x00"
+	})
+
+	if err != nil || v.name == "" {
+		return v, false, err
+	}
+
+	v.prefix, v.suffix, _ = strings.Cut(text, "\x00")
+
+	return v, true, nil
+}
+
+// Create plural rules from forms of the variable by plural category.
+// Specifiers without a position in forms refer to the variable argument.
+func (v appleVariable) rules(forms map[string]string) (map[string]interface{}, error) {
+	if len(forms) == 0 {
+		return nil, fmt.Errorf("variable `%v` has no plural forms", v.name)
+	}
+
+	rules := make(map[string]interface{}, len(forms))
+
+	for category, form := range forms {
+		var err error
+
+		text := replaceAppleVerbs(form, v.arg, func(verb appleVerb) string {
+			if verb.variable != "" {
+				err = fmt.Errorf("nested variable `%v` is not supported", verb.variable)
+			}
+
+			return verb.printf(verb.arg != 0)
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		selector := category
+		if category == "zero" {
+			selector = "=0"
+		}
+
+		rules[selector] = v.prefix + text + v.suffix
+	}
+
+	return argumentRules(v.arg, rules), nil
+}
+
+// Get Apple plural forms of the argument by plural category. The `=0`
+// selector is written as the `zero` form, other selectors which are not
+// plural categories are skipped.
+func appleForms(arg pluralArgument) map[string]string {
+	forms := make(map[string]string)
+
+	for _, selector := range arg.selectors {
+		if isCategory(selector) {
+			forms[selector] = arg.messages[selector]
+		}
+	}
+
+	if text, ok := arg.messages["=0"]; ok {
+		forms["zero"] = text
+	}
+
+	return forms
+}
+
+// Get an Apple specifier of the plural argument value, `lld` by default.
+func appleValueType(arg pluralArgument) string {
+	result := "lld"
+
+	for _, selector := range arg.selectors {
+		replaceVerbs(arg.messages[selector], func(v verb) string {
+			if v.arg == arg.number {
+				result = appleSpecifier(v.char)
+			}
+
+			return ""
+		})
+	}
+
+	return result
+}
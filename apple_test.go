@@ -0,0 +1,311 @@
+package i18n
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/encoding/unicode"
+	"golang.org/x/text/language"
+	"golang.org/x/text/transform"
+)
+
+func Test_StringsDecode(t *testing.T) {
+	t.Parallel()
+
+	text := func(s string) *string { return &s }
+
+	testCases := []struct {
+		name string
+		data string
+		out  []translationMessage
+		err  bool
+	}{
+		{
+			name: "entries",
+			data: `/* Greeting */
+"hello" = "Hello, %@! You have %ld new messages";
+
+// Positional
+"order" = "%2$@ %1$@";
+/* No comment provided by engineer. */
+"escapes" = "Line\n\"quoted\" \\ \U00e9 \UD83D\UDE00 100%%";
+unquoted_key = "value";
+"same";
+`,
+			out: []translationMessage{
+				{ID: "hello", Message: text("Hello, %v! You have %d new messages"), Description: "Greeting"},
+				{ID: "order", Message: text("%[2]v %[1]v"), Description: "Positional"},
+				{ID: "escapes", Message: text("Line\n\"quoted\" \\ é 😀 100%%")},
+				{ID: "unquoted_key", Message: text("value")},
+				{ID: "same", Message: text("same")},
+			},
+		},
+		{name: "empty", data: "/* nothing */\n"},
+		{name: "missing semicolon", data: `"hello" = "Hello"`, err: true},
+		{name: "unclosed string", data: `"hello" = "Hello;`, err: true},
+		{name: "unclosed comment", data: `/* "hello" = "Hello";`, err: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name,
+			func(t *testing.T) {
+				m, err := stringsDecoder{}.decode([]byte(tc.data))
+
+				if tc.err {
+					assert.Error(t, err)
+				} else {
+					assert.NoError(t, err)
+					assert.Equal(t, tc.out, m)
+				}
+			},
+		)
+	}
+}
+
+func Test_StringsDecodeUTF16(t *testing.T) {
+	t.Parallel()
+
+	data, _, err := transform.Bytes(
+		unicode.UTF16(unicode.LittleEndian, unicode.UseBOM).NewEncoder(),
+		[]byte(`"hello" = "Привет";`),
+	)
+	assert.NoError(t, err)
+
+	m, err := stringsDecoder{}.decode(data)
+	assert.NoError(t, err)
+
+	assert.Len(t, m, 1)
+	assert.Equal(t, "Привет", *m[0].Message)
+}
+
+func Test_StringsEncode(t *testing.T) {
+	t.Parallel()
+
+	text := "Hello, %s!\nYou have %d \"new\" messages, 100%%"
+
+	m := []translationMessage{
+		{ID: "apples", Rules: map[string]interface{}{"one": "%d apple", "other": "%d apples"}},
+		{ID: "hello", Message: &text, Description: "Greeting"},
+	}
+
+	var out bytes.Buffer
+
+	assert.NoError(t, encodeStrings(&out, language.English, m))
+	assert.Equal(t, `/* Greeting */
+"hello" = "Hello, %1$@!\nYou have %2$lld \"new\" messages, 100%%";
+`, out.String())
+
+	decoded, err := stringsDecoder{}.decode(out.Bytes())
+	assert.NoError(t, err)
+
+	assert.Equal(t, "Hello, %v!\nYou have %d \"new\" messages, 100%%", *decoded[0].Message)
+}
+
+func Test_StringsdictDecode(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name  string
+		entry string
+		rules interface{}
+		err   bool
+	}{
+		{
+			name: "plural",
+			entry: `<key>NSStringLocalizedFormatKey</key>
+      <string>%#@apples@</string>
+      <key>apples</key>
+      <dict>
+        <key>NSStringFormatSpecTypeKey</key>
+        <string>NSStringPluralRuleType</string>
+        <key>NSStringFormatValueTypeKey</key>
+        <string>ld</string>
+        <key>zero</key>
+        <string>No apples</string>
+        <key>one</key>
+        <string>%ld apple</string>
+        <key>other</key>
+        <string>%ld apples</string>
+      </dict>`,
+			rules: map[string]interface{}{
+				"=0":    "No apples",
+				"one":   "%[1]d apple",
+				"other": "%[1]d apples",
+			},
+		},
+		{
+			name: "text around the variable",
+			entry: `<key>NSStringLocalizedFormatKey</key>
+      <string>%@ has %#@apples@ in %@</string>
+      <key>apples</key>
+      <dict>
+        <key>NSStringFormatSpecTypeKey</key>
+        <string>NSStringPluralRuleType</string>
+        <key>one</key>
+        <string>%d apple</string>
+        <key>other</key>
+        <string>%d apples</string>
+      </dict>`,
+			rules: map[string]interface{}{
+				"2": map[string]interface{}{
+					"one":   "%[1]v has %[2]d apple in %[3]v",
+					"other": "%[1]v has %[2]d apples in %[3]v",
+				},
+			},
+		},
+		{
+			name: "two variables",
+			entry: `<key>NSStringLocalizedFormatKey</key>
+      <string>%#@apples@ %#@pears@</string>`,
+			err: true,
+		},
+		{
+			name: "undefined variable",
+			entry: `<key>NSStringLocalizedFormatKey</key>
+      <string>%#@apples@</string>`,
+			err: true,
+		},
+		{
+			name: "not plural",
+			entry: `<key>NSStringLocalizedFormatKey</key>
+      <string>%#@apples@</string>
+      <key>apples</key>
+      <dict>
+        <key>NSStringFormatSpecTypeKey</key>
+        <string>NSStringDeviceSpecificRuleType</string>
+      </dict>`,
+			err: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name,
+			func(t *testing.T) {
+				m, err := stringsdictDecoder{}.decode([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+  <dict>
+    <key>apples</key>
+    <dict>
+      ` + tc.entry + `
+    </dict>
+  </dict>
+</plist>`))
+
+				if tc.err {
+					assert.Error(t, err)
+				} else {
+					assert.NoError(t, err)
+					assert.Equal(t, []translationMessage{{ID: "apples", Rules: tc.rules}}, m)
+				}
+			},
+		)
+	}
+}
+
+func Test_StringsdictEncode(t *testing.T) {
+	t.Parallel()
+
+	text := "Hello"
+
+	m := []translationMessage{
+		{
+			ID:    "apples",
+			Rules: map[string]interface{}{"=0": "No apples", "one": "%d apple", "other": "%d apples"},
+		},
+		{ID: "hello", Message: &text},
+		{
+			ID:    "price",
+			Rules: map[string]interface{}{"2": map[string]interface{}{"one": "%s: %.2f euro", "other": "%s: %.2f euros"}},
+		},
+	}
+
+	var out bytes.Buffer
+
+	assert.NoError(t, encodeStringsdict(&out, language.English, m))
+	assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>apples</key>
+	<dict>
+		<key>NSStringLocalizedFormatKey</key>
+		<string>%#@value@</string>
+		<key>value</key>
+		<dict>
+			<key>NSStringFormatSpecTypeKey</key>
+			<string>NSStringPluralRuleType</string>
+			<key>NSStringFormatValueTypeKey</key>
+			<string>lld</string>
+			<key>zero</key>
+			<string>No apples</string>
+			<key>one</key>
+			<string>%1$lld apple</string>
+			<key>other</key>
+			<string>%1$lld apples</string>
+		</dict>
+	</dict>
+	<key>price</key>
+	<dict>
+		<key>NSStringLocalizedFormatKey</key>
+		<string>%2$#@value@</string>
+		<key>value</key>
+		<dict>
+			<key>NSStringFormatSpecTypeKey</key>
+			<string>NSStringPluralRuleType</string>
+			<key>NSStringFormatValueTypeKey</key>
+			<string>f</string>
+			<key>one</key>
+			<string>%1$@: %2$.2f euro</string>
+			<key>other</key>
+			<string>%1$@: %2$.2f euros</string>
+		</dict>
+	</dict>
+</dict>
+</plist>
+`, out.String())
+
+	decoded, err := stringsdictDecoder{}.decode(out.Bytes())
+	assert.NoError(t, err)
+
+	assert.Equal(t, []translationMessage{
+		{ID: "apples", Rules: map[string]interface{}{"=0": "No apples", "one": "%[1]d apple", "other": "%[1]d apples"}},
+		{ID: "price", Rules: map[string]interface{}{"2": map[string]interface{}{
+			"one":   "%[1]v: %[2].2f euro",
+			"other": "%[1]v: %[2].2f euros",
+		}}},
+	}, decoded)
+
+	err = encodeStringsdict(&out, language.English, []translationMessage{{
+		ID:    "few apples",
+		Rules: map[string]interface{}{"<5": "A few apples", "other": "%d apples"},
+	}})
+	assert.IsType(t, &ErrorEncode{}, err)
+}
+
+func Test_AppleToPrintf(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		in  string
+		out string
+	}{
+		{in: "%@ and %d", out: "%v and %d"},
+		{in: "%2$@ and %1$lld", out: "%[2]v and %[1]d"},
+		{in: "%1$@ and %@", out: "%v and %v"},
+		{in: "%u %lu %i %5.2f %-3x %'d", out: "%d %d %d %5.2f %-3x %d"},
+		{in: "100%%", out: "100%%"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.in,
+			func(t *testing.T) {
+				assert.Equal(t, tc.out, appleToPrintf(tc.in))
+			},
+		)
+	}
+}
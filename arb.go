@@ -0,0 +1,594 @@
+package i18n
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// FormatARB is a Flutter Application Resource Bundle.
+const FormatARB Format = "arb"
+
+// Decoder of Flutter ARB files. ICU placeholders `{name}` are converted to
+// format verbs `%[n]v`, where n is the placeholder position in the message
+// metadata or, without metadata, in the message. A plural argument
+// `{count, plural, =0{...} one{...} other{...}}` is converted to plural
+// rules, `{count}` and `#` inside it are converted to `%[n]d`. A placeholder
+// with `decimalDigits` metadata is converted to `%[n].Nf`. Quoted ICU text,
+// e.g. `'{'` or `”`, is unquoted.
+type arbDecoder struct{}
+
+// Decode translation messages.
+func (d arbDecoder) Decode(data []byte) ([]Message, error) {
+	return publicMessages(d.decode(data))
+}
+
+// ARB message metadata.
+type arbMeta struct {
+	Description  string          `json:"description,omitempty"`
+	Context      string          `json:"context,omitempty"`
+	Placeholders json.RawMessage `json:"placeholders,omitempty"`
+}
+
+// Decode translation messages.
+func (arbDecoder) decode(data []byte) ([]translationMessage, error) {
+	var v map[string]json.RawMessage
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, err
+	}
+
+	var result []translationMessage
+
+	for key, raw := range v {
+		if strings.HasPrefix(key, "@") {
+			continue
+		}
+
+		var text string
+		if err := json.Unmarshal(raw, &text); err != nil {
+			return nil, &ErrorDecode{Format: "arb", Message: fmt.Sprintf("message `%v` should be a string", key)}
+		}
+
+		var meta arbMeta
+		if rawMeta, ok := v["@"+key]; ok {
+			if err := json.Unmarshal(rawMeta, &meta); err != nil {
+				return nil, err
+			}
+		}
+
+		names, err := objectKeys(meta.Placeholders)
+		if err != nil {
+			return nil, err
+		}
+
+		formats, err := arbFormats(meta.Placeholders)
+		if err != nil {
+			return nil, err
+		}
+
+		m, err := parseICU(text, names, formats)
+		if err != nil {
+			return nil, &ErrorDecode{Format: "arb", Message: fmt.Sprintf("message `%v`: %v", key, err)}
+		}
+
+		m.ID = key
+		m.Description = meta.Description
+		m.Context = meta.Context
+
+		result = append(result, m)
+	}
+
+	sort.Slice(result, func(a, b int) bool { return result[a].ID < result[b].ID })
+
+	return result, nil
+}
+
+// Get keys of a JSON object in order.
+func objectKeys(raw json.RawMessage) ([]string, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+
+	if _, err := decoder.Token(); err != nil {
+		return nil, err
+	}
+
+	var keys []string
+
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, fmt.Sprint(token))
+
+		var value json.RawMessage
+		if err = decoder.Decode(&value); err != nil {
+			return nil, err
+		}
+	}
+
+	return keys, nil
+}
+
+// ARB placeholder metadata.
+type arbPlaceholder struct {
+	Format             string `json:"format"`
+	OptionalParameters struct {
+		DecimalDigits *int `json:"decimalDigits"`
+	} `json:"optionalParameters"`
+}
+
+// Get format verbs without `%` of placeholders by name, e.g. `.2f` for
+// `decimalDigits` metadata.
+func arbFormats(raw json.RawMessage) (map[string]string, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	var placeholders map[string]arbPlaceholder
+	if err := json.Unmarshal(raw, &placeholders); err != nil {
+		return nil, err
+	}
+
+	formats := make(map[string]string)
+
+	for name, p := range placeholders {
+		if digits := p.OptionalParameters.DecimalDigits; digits != nil && p.Format == "decimalPatternDigits" {
+			formats[name] = fmt.Sprintf(".%vf", *digits)
+		}
+	}
+
+	return formats, nil
+}
+
+// Parse an ICU message to a message text or plural rules. Only one plural
+// argument is supported, a text around it is added to every plural form.
+func parseICU(s string, names []string, formats map[string]string) (translationMessage, error) {
+	parts, err := splitICU(s)
+	if err != nil {
+		return translationMessage{}, err
+	}
+
+	var m translationMessage
+	var prefix, suffix strings.Builder
+	var plural *icuArgument
+
+	for _, part := range parts {
+		current := &prefix
+		if plural != nil {
+			current = &suffix
+		}
+
+		if part.argument == nil {
+			current.WriteString(unquoteICU(part.text, ""))
+			continue
+		}
+
+		switch part.argument.kind {
+		case "":
+			current.WriteString(icuVerb(&names, formats, part.argument.name, 'v'))
+		case "plural":
+			if plural != nil {
+				return m, fmt.Errorf("only one plural argument is supported")
+			}
+
+			plural = part.argument
+		default:
+			return m, fmt.Errorf("`%v` argument is not supported", part.argument.kind)
+		}
+	}
+
+	if plural == nil {
+		text := prefix.String()
+		m.Message = &text
+
+		return m, nil
+	}
+
+	arg := placeholder(&names, plural.name)
+
+	rules := make(map[string]interface{}, len(plural.forms))
+
+	for selector, text := range plural.forms {
+		form, err := parseICUForm(text, plural.name, &names, formats)
+		if err != nil {
+			return m, err
+		}
+
+		rules[selector] = prefix.String() + form + suffix.String()
+	}
+
+	m.Rules = argumentRules(arg, rules)
+
+	return m, nil
+}
+
+// Parse a text of a plural form.
+func parseICUForm(s, count string, names *[]string, formats map[string]string) (string, error) {
+	parts, err := splitICU(s)
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+
+	for _, part := range parts {
+		if part.argument == nil {
+			b.WriteString(unquoteICU(part.text, icuVerb(names, formats, count, 'd')))
+
+			continue
+		}
+
+		if part.argument.kind != "" {
+			return "", fmt.Errorf("nested `%v` argument is not supported", part.argument.kind)
+		}
+
+		if part.argument.name == count {
+			b.WriteString(icuVerb(names, formats, count, 'd'))
+		} else {
+			b.WriteString(icuVerb(names, formats, part.argument.name, 'v'))
+		}
+	}
+
+	return b.String(), nil
+}
+
+// Get a format verb for the placeholder, the verb character is used if
+// there is no format of the placeholder.
+func icuVerb(names *[]string, formats map[string]string, name string, char byte) string {
+	format, ok := formats[name]
+	if !ok {
+		format = string(char)
+	}
+
+	return fmt.Sprintf("%%[%v]%v", placeholder(names, name), format)
+}
+
+// Convert an ICU text to a format string: quoted text is unquoted and `%` is
+// escaped. Unquoted `#` is replaced with the count verb if it's not empty.
+func unquoteICU(s, count string) string {
+	var b strings.Builder
+
+	quoted := false
+
+	for n := 0; n < len(s); n++ {
+		switch c := s[n]; {
+		case c == '\'' && n+1 < len(s) && s[n+1] == '\'':
+			b.WriteByte('\'')
+			n++
+		case c == '\'' && (quoted || n+1 < len(s) && isICUSyntax(s[n+1])):
+			quoted = !quoted
+		case c == '%':
+			b.WriteString("%%")
+		case c == '#' && !quoted && count != "":
+			b.WriteString(count)
+		default:
+			b.WriteByte(c)
+		}
+	}
+
+	return b.String()
+}
+
+// Find the first of the characters outside of ICU quoted text.
+func indexICU(s string, chars string) int {
+	quoted := false
+
+	for n := 0; n < len(s); n++ {
+		switch c := s[n]; {
+		case c == '\'' && n+1 < len(s) && s[n+1] == '\'':
+			n++
+		case c == '\'' && (quoted || n+1 < len(s) && isICUSyntax(s[n+1])):
+			quoted = !quoted
+		case !quoted && strings.IndexByte(chars, c) >= 0:
+			return n
+		}
+	}
+
+	return -1
+}
+
+// Check if an apostrophe before the character starts ICU quoted text.
+func isICUSyntax(c byte) bool {
+	return c == '{' || c == '}' || c == '#' || c == '|'
+}
+
+// Get an argument number of the placeholder, unknown placeholders are
+// appended to names.
+func placeholder(names *[]string, name string) int {
+	for n, v := range *names {
+		if v == name {
+			return n + 1
+		}
+	}
+
+	*names = append(*names, name)
+
+	return len(*names)
+}
+
+// Part of an ICU message: a text or an argument.
+type icuPart struct {
+	text     string
+	argument *icuArgument
+}
+
+// ICU message argument.
+type icuArgument struct {
+	name string
+	// Argument type, e.g. `plural`, empty for a simple placeholder.
+	kind string
+	// Plural forms by selector.
+	forms map[string]string
+}
+
+// Split an ICU message to texts and arguments.
+func splitICU(s string) ([]icuPart, error) {
+	var parts []icuPart
+
+	for len(s) > 0 {
+		start := indexICU(s, "{")
+		if start < 0 {
+			parts = append(parts, icuPart{text: s})
+			break
+		}
+
+		if start > 0 {
+			parts = append(parts, icuPart{text: s[:start]})
+		}
+
+		end, err := matchBrace(s, start)
+		if err != nil {
+			return nil, err
+		}
+
+		argument, err := parseICUArgument(s[start+1 : end])
+		if err != nil {
+			return nil, err
+		}
+
+		parts = append(parts, icuPart{argument: argument})
+		s = s[end+1:]
+	}
+
+	return parts, nil
+}
+
+// Find the closing brace for the opening brace at the position, braces of
+// quoted text are skipped.
+func matchBrace(s string, start int) (int, error) {
+	depth := 0
+
+	for n := start; ; n++ {
+		next := indexICU(s[n:], "{}")
+		if next < 0 {
+			break
+		}
+
+		n += next
+
+		if s[n] == '{' {
+			depth++
+			continue
+		}
+
+		if depth--; depth == 0 {
+			return n, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unclosed brace in `%v`", s)
+}
+
+// Parse an ICU argument without braces.
+func parseICUArgument(s string) (*icuArgument, error) {
+	fields := strings.SplitN(s, ",", 3)
+	argument := &icuArgument{name: strings.TrimSpace(fields[0])}
+
+	if len(fields) == 1 {
+		return argument, nil
+	}
+
+	argument.kind = strings.TrimSpace(fields[1])
+	if argument.kind != "plural" || len(fields) < 3 {
+		return argument, nil
+	}
+
+	argument.forms = make(map[string]string)
+
+	rest := strings.TrimSpace(fields[2])
+
+	for len(rest) > 0 {
+		start := strings.IndexByte(rest, '{')
+		if start < 0 {
+			return nil, fmt.Errorf("wrong plural argument `%v`", s)
+		}
+
+		selector := strings.TrimSpace(rest[:start])
+		if !isSelector(selector) {
+			return nil, fmt.Errorf("plural selector `%v` is not supported", selector)
+		}
+
+		end, err := matchBrace(rest, start)
+		if err != nil {
+			return nil, err
+		}
+
+		argument.forms[selector] = rest[start+1 : end]
+		rest = strings.TrimSpace(rest[end+1:])
+	}
+
+	return argument, nil
+}
+
+// Encode messages as Flutter ARB file. Format verbs are written as ICU
+// placeholders `{argN}`, a precision of `%.Nf` as placeholder metadata.
+// Literal `'`, `{`, `}` and `#` of plural forms are quoted. A message with
+// other flags, width or precision, several plural arguments or plural
+// selectors `<N` and `>N` can't be exported, they aren't supported by ICU.
+func encodeARB(w io.Writer, tag language.Tag, m []translationMessage) error {
+	result := map[string]interface{}{"@@locale": tag.String()}
+
+	for _, message := range m {
+		var text string
+		var args map[string]interface{}
+		var err error
+
+		if message.Message != nil {
+			text, args, err = printfToICU(*message.Message, 0)
+		} else {
+			text, args, err = rulesToICU(pluralArguments(message.Rules))
+		}
+
+		if err != nil {
+			return &ErrorEncode{Format: FormatARB, MessageID: message.ID, Message: err.Error()}
+		}
+
+		result[message.ID] = text
+
+		meta := make(map[string]interface{})
+
+		if message.Description != "" {
+			meta["description"] = message.Description
+		}
+
+		if message.Context != "" {
+			meta["context"] = message.Context
+		}
+
+		if len(args) > 0 {
+			meta["placeholders"] = args
+		}
+
+		if len(meta) > 0 {
+			result["@"+message.ID] = meta
+		}
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(result)
+}
+
+// Convert format verbs to ICU placeholders and quote ICU syntax characters
+// of texts. The count argument is written as `#`.
+func printfToICU(s string, count int) (string, map[string]interface{}, error) {
+	args := make(map[string]interface{})
+
+	var err error
+
+	s = replaceVerbsText(s, func(text string) string { return quoteICU(text, count != 0) }, func(v verb) string {
+		if v.arg == 0 {
+			return "%"
+		}
+
+		meta, ok := icuPlaceholder(v)
+		if !ok {
+			err = fmt.Errorf("format verb `%v` is not supported", v)
+		}
+
+		if v.arg == count && meta == nil {
+			return "#"
+		}
+
+		name := "arg" + strconv.Itoa(v.arg)
+		args[name] = meta
+
+		if meta == nil {
+			args[name] = map[string]interface{}{}
+		}
+
+		return "{" + name + "}"
+	})
+
+	return s, args, err
+}
+
+// Get placeholder metadata of the format verb, nil if it's not needed.
+// Only a precision of `%.Nf` can be written.
+func icuPlaceholder(v verb) (map[string]interface{}, bool) {
+	switch {
+	case v.flags == "" && v.width == "":
+		return nil, true
+	case v.flags != "" || v.char != 'f' || !strings.HasPrefix(v.width, "."):
+		return nil, false
+	}
+
+	digits, _ := strconv.Atoi(v.width[1:])
+
+	return map[string]interface{}{
+		"type":               "double",
+		"format":             "decimalPatternDigits",
+		"optionalParameters": map[string]interface{}{"decimalDigits": digits},
+	}, true
+}
+
+// Quote ICU syntax characters of a text, `#` is quoted only in plural forms.
+func quoteICU(s string, plural bool) string {
+	var b strings.Builder
+
+	for _, r := range s {
+		switch {
+		case r == '\'':
+			b.WriteString("''")
+		case r == '{' || r == '}' || r == '#' && plural:
+			b.WriteString("'" + string(r) + "'")
+		default:
+			b.WriteRune(r)
+		}
+	}
+
+	return b.String()
+}
+
+// Convert plural rules of an argument to an ICU plural argument.
+func rulesToICU(arguments []pluralArgument) (string, map[string]interface{}, error) {
+	switch {
+	case len(arguments) > 1:
+		return "", nil, fmt.Errorf("several plural arguments")
+	case len(arguments) == 0:
+		return "", nil, nil
+	}
+
+	arg := arguments[0]
+	if text, ok := comparisonError(arg); ok {
+		return "", nil, errors.New(text)
+	}
+
+	name := "arg" + strconv.Itoa(arg.number)
+	args := map[string]interface{}{name: map[string]interface{}{}}
+
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "{%v, plural,", name)
+
+	for _, selector := range arg.selectors {
+		text, formArgs, err := printfToICU(arg.messages[selector], arg.number)
+		if err != nil {
+			return "", nil, err
+		}
+
+		for key, value := range formArgs {
+			args[key] = value
+		}
+
+		fmt.Fprintf(&b, " %v{%v}", selector, text)
+	}
+
+	b.WriteString("}")
+
+	return b.String(), args, nil
+}
@@ -0,0 +1,221 @@
+package i18n
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+func Test_ARBDecode(t *testing.T) {
+	t.Parallel()
+
+	text := func(s string) *string { return &s }
+
+	testCases := []struct {
+		name string
+		data string
+		out  []translationMessage
+		err  bool
+	}{
+		{
+			name: "message with metadata",
+			data: `{
+        "@@locale": "en",
+        "hello": "Hello, {firstName} {lastName}! 100%",
+        "@hello": {
+          "description": "Greeting",
+          "placeholders": {"lastName": {}, "firstName": {}}
+        }
+      }`,
+			out: []translationMessage{
+				{ID: "hello", Message: text("Hello, %[2]v %[1]v! 100%%"), Description: "Greeting"},
+			},
+		},
+		{
+			name: "plural",
+			data: `{"apples": "{count, plural, =0{No apples} one{One apple} other{{count} apples}}"}`,
+			out: []translationMessage{
+				{
+					ID: "apples",
+					Rules: map[string]interface{}{
+						"=0":    "No apples",
+						"one":   "One apple",
+						"other": "%[1]d apples",
+					},
+				},
+			},
+		},
+		{
+			name: "plural with text and placeholders",
+			data: `{
+        "cart": "{name}, you have {count, plural, one{# item} other{# items}} in {place}.",
+        "@cart": {"placeholders": {"name": {}, "count": {}, "place": {}}}
+      }`,
+			out: []translationMessage{
+				{
+					ID: "cart",
+					Rules: map[string]interface{}{
+						"2": map[string]interface{}{
+							"one":   "%[1]v, you have %[2]d item in %[3]v.",
+							"other": "%[1]v, you have %[2]d items in %[3]v.",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "quoted text",
+			data: `{"braces": "It's '{literal}' and ''{name}''"}`,
+			out: []translationMessage{
+				{ID: "braces", Message: text("It's {literal} and '%[1]v'")},
+			},
+		},
+		{
+			name: "select is not supported",
+			data: `{"pronoun": "{gender, select, male{he} other{they}}"}`,
+			err:  true,
+		},
+		{
+			name: "unclosed brace",
+			data: `{"hello": "Hello, {name"}`,
+			err:  true,
+		},
+		{
+			name: "message is not a string",
+			data: `{"hello": 1}`,
+			err:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name,
+			func(t *testing.T) {
+				m, err := arbDecoder{}.decode([]byte(tc.data))
+
+				if tc.err {
+					assert.Error(t, err)
+				} else {
+					assert.NoError(t, err)
+					assert.Equal(t, tc.out, m)
+				}
+			},
+		)
+	}
+}
+
+func Test_ARBPrint(t *testing.T) {
+	t.Parallel()
+
+	m, err := arbDecoder{}.decode([]byte(`{
+    "arb cart": "{name}, you have {count, plural, =0{no items} one{# item} other{# items}}.",
+    "@arb cart": {"placeholders": {"name": {}, "count": {}}}
+  }`))
+	assert.NoError(t, err)
+
+	assert.NoError(t, (&translation{tag: language.English}).loadMessages(m))
+
+	p := message.NewPrinter(language.English)
+
+	assert.Equal(t, "Ivan, you have no items.", p.Sprintf("arb cart", "Ivan", 0))
+	assert.Equal(t, "Ivan, you have 1 item.", p.Sprintf("arb cart", "Ivan", 1))
+	assert.Equal(t, "Ivan, you have 5 items.", p.Sprintf("arb cart", "Ivan", 5))
+}
+
+func Test_ARBEncode(t *testing.T) {
+	t.Parallel()
+
+	text := "Привет, %s! Use {braces} and 'quotes', 100%%"
+	price := "Цена: %.2f"
+	width := "%5d"
+
+	m := []translationMessage{
+		{
+			ID:    "apples",
+			Rules: map[string]interface{}{"one": "%d яблоко #1", "many": "%d яблок", "=0": "нет яблок"},
+		},
+		{ID: "hello", Message: &text, Description: "Greeting"},
+		{ID: "price", Message: &price},
+	}
+
+	var out bytes.Buffer
+
+	assert.NoError(t, encodeARB(&out, language.Russian, m))
+	assert.Equal(t, `{
+  "@@locale": "ru",
+  "@apples": {
+    "placeholders": {
+      "arg1": {}
+    }
+  },
+  "@hello": {
+    "description": "Greeting",
+    "placeholders": {
+      "arg1": {}
+    }
+  },
+  "@price": {
+    "placeholders": {
+      "arg1": {
+        "format": "decimalPatternDigits",
+        "optionalParameters": {
+          "decimalDigits": 2
+        },
+        "type": "double"
+      }
+    }
+  },
+  "apples": "{arg1, plural, =0{нет яблок} one{# яблоко '#'1} many{# яблок}}",
+  "hello": "Привет, {arg1}! Use '{'braces'}' and ''quotes'', 100%",
+  "price": "Цена: {arg1}"
+}
+`, out.String())
+
+	decoded, err := arbDecoder{}.decode(out.Bytes())
+	assert.NoError(t, err)
+
+	assert.Equal(t, "Привет, %[1]v! Use {braces} and 'quotes', 100%%", *decoded[1].Message)
+	assert.Equal(t, "Цена: %[1].2f", *decoded[2].Message)
+	assert.Equal(
+		t,
+		map[string]interface{}{"=0": "нет яблок", "one": "%[1]d яблоко #1", "many": "%[1]d яблок"},
+		decoded[0].Rules,
+	)
+
+	testCases := []struct {
+		name    string
+		message translationMessage
+	}{
+		{
+			name: "several plural arguments",
+			message: translationMessage{
+				ID: "files",
+				Rules: map[string]interface{}{
+					"1": map[string]interface{}{"one": "%d папка", "other": "%d папок"},
+					"2": map[string]interface{}{"one": "%d файл", "other": "%d файлов"},
+				},
+			},
+		},
+		{
+			name:    "comparison selector",
+			message: translationMessage{ID: "apples", Rules: map[string]interface{}{"<5": "мало", "other": "%d яблок"}},
+		},
+		{
+			name:    "width",
+			message: translationMessage{ID: "count", Message: &width},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name,
+			func(t *testing.T) {
+				err := encodeARB(&bytes.Buffer{}, language.Russian, []translationMessage{tc.message})
+				assert.IsType(t, &ErrorEncode{}, err)
+			},
+		)
+	}
+}
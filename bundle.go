@@ -0,0 +1,201 @@
+package i18n
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"sort"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+// Load a single file bundle with translations for all languages.
+//
+// The bundle is either grouped by language:
+//
+//	{"en": [{"id": "hello", "message": "Hello"}], "ru": [...]}
+//
+// or grouped by message id:
+//
+//	{"hello": {"en": "Hello", "ru": "Привет"}}
+//
+// A bundle is grouped by language if all its keys are language tags.
+//
+// Files of formats with several languages, e.g. Xcode String Catalogs, are
+// decoded by their decoder.
+func (i *I18n) loadBundle() error {
+	b, err := i.readFile(i.config.Path)
+	if err != nil {
+		return err
+	}
+
+	messages, err := i.decodeBundle(b)
+	if err != nil {
+		return err
+	}
+
+	tags := make([]language.Tag, 0, len(messages))
+	for tag := range messages {
+		tags = append(tags, tag)
+	}
+
+	sort.Slice(tags, func(a, b int) bool { return tags[a].String() < tags[b].String() })
+
+	for _, tag := range tags {
+		i.printer[tag] = message.NewPrinter(tag)
+	}
+
+	if err = i.fallback(); err != nil {
+		return err
+	}
+
+	for _, tag := range tags {
+		tr := &translation{tag: tag, filePath: i.config.Path, index: i.index}
+		if err = tr.loadMessages(messages[tag]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Decode bundle messages grouped by language.
+func (i *I18n) decodeBundle(b []byte) (map[language.Tag][]translationMessage, error) {
+	if d, ok := decoderFor(filepath.Ext(i.config.Path)); ok {
+		if d, ok := d.(bundleDecoder); ok {
+			messages, err := d.decodeBundle(b)
+			if err != nil {
+				return nil, err
+			}
+
+			for tag := range messages {
+				i.languages = append(i.languages, lang{tag: tag})
+			}
+
+			return messages, nil
+		}
+	}
+
+	b, err := toJSON(i.config.Path, b)
+	if err != nil {
+		return nil, err
+	}
+
+	var bundle map[string]json.RawMessage
+	if err = json.Unmarshal(b, &bundle); err != nil {
+		return nil, err
+	}
+
+	return i.bundleMessages(bundle)
+}
+
+// Group bundle messages by language.
+func (i *I18n) bundleMessages(
+	bundle map[string]json.RawMessage,
+) (map[language.Tag][]translationMessage, error) {
+	if byID(bundle) {
+		return i.bundleByID(bundle)
+	}
+
+	result := make(map[language.Tag][]translationMessage, len(bundle))
+
+	for key, raw := range bundle {
+		tag, err := language.Parse(key)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := result[tag]; ok {
+			return nil, &ErrorLanguageTagAlreadyExists{Tag: tag}
+		}
+
+		m, err := decodeMessages(raw)
+		if err != nil {
+			return nil, err
+		}
+
+		result[tag] = m
+		i.languages = append(i.languages, lang{tag: tag})
+	}
+
+	return result, nil
+}
+
+// Group messages of the bundle keyed by message id.
+func (i *I18n) bundleByID(
+	bundle map[string]json.RawMessage,
+) (map[language.Tag][]translationMessage, error) {
+	ids := make([]string, 0, len(bundle))
+	for id := range bundle {
+		ids = append(ids, id)
+	}
+
+	sort.Strings(ids)
+
+	result := make(map[language.Tag][]translationMessage)
+
+	for _, id := range ids {
+		var values map[string]interface{}
+		if err := json.Unmarshal(bundle[id], &values); err != nil {
+			return nil, err
+		}
+
+		for key, value := range values {
+			tag, err := language.Parse(key)
+			if err != nil {
+				return nil, err
+			}
+
+			m := translationMessage{ID: id}
+
+			switch value := value.(type) {
+			case string:
+				m.Message = &value
+			default:
+				m.Rules = value
+			}
+
+			if _, ok := result[tag]; !ok {
+				i.languages = append(i.languages, lang{tag: tag})
+			}
+
+			result[tag] = append(result[tag], m)
+		}
+	}
+
+	return result, nil
+}
+
+// Check if the bundle is keyed by message id: some key isn't a language tag
+// and every value is an object keyed by language tags. A bundle with all keys
+// being language tags is grouped by language, even if the values look like
+// messages keyed by id, e.g. `{"en": {"yes": "Yes", "no": "No"}}`.
+func byID(bundle map[string]json.RawMessage) bool {
+	tags := true
+
+	for key := range bundle {
+		if _, err := language.Parse(key); err != nil {
+			tags = false
+			break
+		}
+	}
+
+	if tags {
+		return false
+	}
+
+	for _, raw := range bundle {
+		var values map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &values); err != nil || len(values) == 0 {
+			return false
+		}
+
+		for key := range values {
+			if _, err := language.Parse(key); err != nil {
+				return false
+			}
+		}
+	}
+
+	return true
+}
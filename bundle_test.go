@@ -0,0 +1,94 @@
+package i18n
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+)
+
+func Test_I18nLoadBundle(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+		file string
+		data string
+		id   string
+		err  bool
+	}{
+		{
+			name: "grouped by language",
+			file: "bundle.json",
+			data: `{
+        "en": [{"id": "bundle by language", "message": "Hello"}],
+        "ru": [{"id": "bundle by language", "message": "Привет"}]
+      }`,
+			id: "bundle by language",
+		},
+		{
+			name: "grouped by id",
+			file: "bundle.yaml",
+			data: `
+bundle by id:
+  en: Hello
+  ru: Привет
+`,
+			id: "bundle by id",
+		},
+		{
+			name: "grouped by language with ids like tags",
+			file: "bundle.json",
+			data: `{
+        "en": {"yes": "Hello", "no": "No"},
+        "ru": {"yes": "Привет", "no": "Нет"}
+      }`,
+			id: "yes",
+		},
+		{
+			name: "wrong language tag",
+			file: "bundle.json",
+			data: `{"-": [{"id": "bundle wrong tag", "message": "Hello"}]}`,
+			err:  true,
+		},
+		{
+			name: "duplicate language tag",
+			file: "bundle.json",
+			data: `{
+        "en": [{"id": "bundle duplicate", "message": "Hello"}],
+        "EN": [{"id": "bundle duplicate", "message": "Hello"}]
+      }`,
+			err: true,
+		},
+		{
+			name: "fallback language doesn't exists",
+			file: "bundle.json",
+			data: `{"ru": [{"id": "bundle fallback", "message": "Привет"}]}`,
+			err:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name,
+			func(t *testing.T) {
+				path := filepath.Join(t.TempDir(), tc.file)
+				assert.NoError(t, os.WriteFile(path, []byte(tc.data), 0o600))
+
+				i18n := New(&Config{Path: path, Fallback: language.English})
+				err := i18n.Load()
+
+				if tc.err {
+					assert.Error(t, err)
+					return
+				}
+
+				assert.NoError(t, err)
+				assert.Equal(t, "Hello", i18n.Printer(language.English).Sprintf(tc.id))
+				assert.Equal(t, "Привет", i18n.Printer(language.Russian).Sprintf(tc.id))
+			},
+		)
+	}
+}
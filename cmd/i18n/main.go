@@ -0,0 +1,67 @@
+// Command i18n converts translation files between supported formats.
+//
+// Usage:
+//
+//	i18n -path ./i18n -tag ru -format po > ru.po
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/yarigo/i18n/v2"
+	"golang.org/x/text/language"
+)
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// Load translations and export a language.
+func run(args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("i18n", flag.ContinueOnError)
+
+	path := flags.String("path", "./i18n", "path to the languages folder or bundle file")
+	tag := flags.String("tag", "", "language tag to export")
+	format := flags.String(
+		"format",
+		string(i18n.FormatJSON),
+		"export format: json, yaml, flat-json, po, xliff, arb, android, strings, stringsdict, xcstrings",
+	)
+	output := flags.String("o", "", "output file, standard output by default")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	lang, err := language.Parse(*tag)
+	if err != nil {
+		return err
+	}
+
+	t := i18n.New(&i18n.Config{Path: *path})
+	if err = t.Load(); err != nil {
+		return err
+	}
+
+	if *output == "" {
+		return t.Export(stdout, lang, i18n.Format(*format))
+	}
+
+	f, err := os.Create(*output)
+	if err != nil {
+		return err
+	}
+
+	if err = t.Export(f, lang, i18n.Format(*format)); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Run(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "bundle.yaml")
+	assert.NoError(t, os.WriteFile(path, []byte("cli hello:\n  en: Hello\n"), 0o600))
+
+	testCases := []struct {
+		name string
+		args []string
+		out  string
+		err  bool
+	}{
+		{
+			name: "successfully",
+			args: []string{"-path", path, "-tag", "en", "-format", "flat-json"},
+			out:  "{\n  \"cli hello\": \"Hello\"\n}\n",
+		},
+		{
+			name: "wrong language tag",
+			args: []string{"-path", path, "-tag", "-"},
+			err:  true,
+		},
+		{
+			name: "wrong format",
+			args: []string{"-path", path, "-tag", "en", "-format", "xml"},
+			err:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name,
+			func(t *testing.T) {
+				var out bytes.Buffer
+
+				err := run(tc.args, &out)
+
+				if tc.err {
+					assert.Error(t, err)
+				} else {
+					assert.NoError(t, err)
+					assert.Equal(t, tc.out, out.String())
+				}
+			},
+		)
+	}
+}
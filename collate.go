@@ -0,0 +1,66 @@
+package i18n
+
+import (
+	"bytes"
+	"reflect"
+	"sort"
+
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+)
+
+// Collator returns a collator of the best loaded language for the tag, the
+// fallback language if no language matches. A collator is not safe for
+// concurrent use.
+func (i *I18n) Collator(tag language.Tag, opts ...collate.Option) *collate.Collator {
+	tag, _ = i.Match(tag)
+
+	return collate.New(tag, opts...)
+}
+
+// SortStrings sorts strings in the order of the best loaded language for the
+// tag.
+func (i *I18n) SortStrings(tag language.Tag, s []string, opts ...collate.Option) {
+	i.Collator(tag, opts...).SortStrings(s)
+}
+
+// SortSlice sorts a slice by a string key of its elements in the order of the
+// best loaded language for the tag, like sort.SliceStable does. It panics if
+// slice is not a slice.
+//
+//	t.SortSlice(tag, countries, func(n int) string { return countries[n].Name })
+func (i *I18n) SortSlice(tag language.Tag, slice interface{}, key func(n int) string, opts ...collate.Option) {
+	c := i.Collator(tag, opts...)
+	length := reflect.ValueOf(slice).Len()
+
+	var buf collate.Buffer
+
+	s := collated{keys: make([][]byte, length), swap: reflect.Swapper(slice)}
+	for n := range s.keys {
+		s.keys[n] = c.KeyFromString(&buf, key(n))
+	}
+
+	sort.Stable(s)
+}
+
+// Slice with collation keys of elements.
+type collated struct {
+	keys [][]byte
+	swap func(a, b int)
+}
+
+// Len is the number of elements.
+func (c collated) Len() int {
+	return len(c.keys)
+}
+
+// Less compares collation keys of elements.
+func (c collated) Less(a, b int) bool {
+	return bytes.Compare(c.keys[a], c.keys[b]) < 0
+}
+
+// Swap swaps elements and their keys.
+func (c collated) Swap(a, b int) {
+	c.keys[a], c.keys[b] = c.keys[b], c.keys[a]
+	c.swap(a, b)
+}
@@ -0,0 +1,102 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+func Test_I18nSortStrings(t *testing.T) {
+	t.Parallel()
+
+	swedish := language.Swedish
+
+	i18n := New(&Config{Fallback: language.English})
+
+	for _, tag := range []language.Tag{language.English, language.German, swedish} {
+		i18n.printer[tag] = message.NewPrinter(tag)
+	}
+
+	testCases := []struct {
+		name string
+		tag  language.Tag
+		opts []collate.Option
+		in   []string
+		out  []string
+	}{
+		{
+			name: "german",
+			tag:  language.German,
+			in:   []string{"Zebra", "Äpfel", "apfel", "Birne"},
+			out:  []string{"apfel", "Äpfel", "Birne", "Zebra"},
+		},
+		{
+			name: "swedish",
+			tag:  swedish,
+			in:   []string{"ö", "z", "a"},
+			out:  []string{"a", "z", "ö"},
+		},
+		{
+			name: "regional language",
+			tag:  language.MustParse("sv-FI"),
+			in:   []string{"ö", "z", "a"},
+			out:  []string{"a", "z", "ö"},
+		},
+		{
+			name: "fallback language",
+			tag:  language.Japanese,
+			in:   []string{"ö", "z", "a"},
+			out:  []string{"a", "ö", "z"},
+		},
+		{
+			name: "options",
+			tag:  language.English,
+			opts: []collate.Option{collate.Numeric},
+			in:   []string{"file10", "file9", "file1"},
+			out:  []string{"file1", "file9", "file10"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name,
+			func(t *testing.T) {
+				s := append([]string(nil), tc.in...)
+				i18n.SortStrings(tc.tag, s, tc.opts...)
+
+				assert.Equal(t, tc.out, s)
+			},
+		)
+	}
+}
+
+func Test_I18nSortSlice(t *testing.T) {
+	t.Parallel()
+
+	i18n := New(&Config{Fallback: language.English})
+	i18n.printer[language.Swedish] = message.NewPrinter(language.Swedish)
+
+	type country struct {
+		Code string
+		Name string
+	}
+
+	countries := []country{
+		{Code: "AT", Name: "Österrike"},
+		{Code: "SE", Name: "Sverige"},
+		{Code: "DK", Name: "Danmark"},
+		{Code: "AX", Name: "Åland"},
+	}
+
+	i18n.SortSlice(language.Swedish, countries, func(n int) string { return countries[n].Name })
+
+	assert.Equal(t, []country{
+		{Code: "DK", Name: "Danmark"},
+		{Code: "SE", Name: "Sverige"},
+		{Code: "AX", Name: "Åland"},
+		{Code: "AT", Name: "Österrike"},
+	}, countries)
+}
@@ -0,0 +1,42 @@
+package i18n
+
+import (
+	"context"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+// Key of the printer in a context.
+type printerKey struct{}
+
+// Key of the language in a context.
+type languageKey struct{}
+
+// NewContext returns a copy of the context with the printer.
+func NewContext(ctx context.Context, p *message.Printer) context.Context {
+	return context.WithValue(ctx, printerKey{}, p)
+}
+
+// FromContext returns the printer stored in the context. If the context has
+// no printer, a printer for the undefined language is returned, it prints
+// message ids as is.
+func FromContext(ctx context.Context) *message.Printer {
+	if p, ok := ctx.Value(printerKey{}).(*message.Printer); ok && p != nil {
+		return p
+	}
+
+	return message.NewPrinter(language.Und)
+}
+
+// NewLanguageContext returns a copy of the context with the language tag.
+func NewLanguageContext(ctx context.Context, tag language.Tag) context.Context {
+	return context.WithValue(ctx, languageKey{}, tag)
+}
+
+// LanguageFromContext returns the language tag stored in the context.
+func LanguageFromContext(ctx context.Context) (language.Tag, bool) {
+	tag, ok := ctx.Value(languageKey{}).(language.Tag)
+
+	return tag, ok
+}
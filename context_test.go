@@ -0,0 +1,30 @@
+package i18n
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+func Test_FromContext(t *testing.T) {
+	t.Parallel()
+
+	p := message.NewPrinter(language.Russian)
+
+	assert.Same(t, p, FromContext(NewContext(context.Background(), p)))
+	assert.NotNil(t, FromContext(context.Background()))
+}
+
+func Test_LanguageFromContext(t *testing.T) {
+	t.Parallel()
+
+	tag, ok := LanguageFromContext(NewLanguageContext(context.Background(), language.Russian))
+	assert.True(t, ok)
+	assert.Equal(t, language.Russian, tag)
+
+	_, ok = LanguageFromContext(context.Background())
+	assert.False(t, ok)
+}
@@ -0,0 +1,324 @@
+package i18n
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/number"
+)
+
+// Style of a formatted date or time.
+type Style int
+
+// Date and time styles of CLDR.
+const (
+	// Short is a numeric style, e.g. `1/2/06` or `3:04 PM`.
+	Short Style = iota
+	// Medium is a style with abbreviated names, e.g. `Jan 2, 2006`.
+	Medium
+	// Long is a style with full names, e.g. `January 2, 2006`.
+	Long
+	// Full is a style with a weekday or a time zone, e.g.
+	// `Monday, January 2, 2006`.
+	Full
+)
+
+// Date formats the date of a time in the style of the printer language, see
+// FormattingOf:
+//
+//	p.Sprintf("Updated %v", i18n.Date(t, i18n.Long)) // Updated January 2, 2006
+func Date(t time.Time, style Style) fmt.Formatter {
+	return dateTime{time: t, date: style, clock: -1}
+}
+
+// Time formats the clock time of a time in the style of the printer language.
+// Time zones are written as abbreviations in all styles.
+func Time(t time.Time, style Style) fmt.Formatter {
+	return dateTime{time: t, date: -1, clock: style}
+}
+
+// DateTime formats the date and the clock time of a time in the styles of
+// the printer language.
+func DateTime(t time.Time, date, clock Style) fmt.Formatter {
+	return dateTime{time: t, date: date, clock: clock}
+}
+
+// RelativeTime formats a duration relative to now in the printer language,
+// e.g. `3 days ago` for a negative duration or `in 2 hours` for a positive
+// one. The largest whole unit from seconds to years is used.
+func RelativeTime(d time.Duration) fmt.Formatter {
+	return relativeTime(d)
+}
+
+// Calendar data of a language.
+type calendar struct {
+	months      [12]string
+	monthsShort [12]string
+	// Stand-alone month names, empty if they are the same as the names of
+	// the format context.
+	standaloneMonths      [12]string
+	standaloneMonthsShort [12]string
+	weekdays              [7]string
+	weekdaysShort         [7]string
+	dayPeriods            [2]string
+	// Date, time and date time combination patterns by style.
+	date     [4]string
+	time     [4]string
+	dateTime [4]string
+	relative relativeData
+}
+
+// Relative time data of a language: future and past patterns by unit and
+// plural category.
+type relativeData struct {
+	now    string
+	future map[string]map[string]string
+	past   map[string]map[string]string
+}
+
+// Date, time or both, -1 skips a part.
+type dateTime struct {
+	time  time.Time
+	date  Style
+	clock Style
+}
+
+// Format the date and time with the printer language.
+func (d dateTime) Format(s fmt.State, _ rune) {
+	data := calendarData(stateLanguage(s))
+
+	var date, clock string
+
+	if d.date >= Short && d.date <= Full {
+		date = data.formatTime(d.time, data.date[d.date])
+	}
+
+	if d.clock >= Short && d.clock <= Full {
+		clock = data.formatTime(d.time, data.time[d.clock])
+	}
+
+	switch {
+	case date == "":
+		fmt.Fprint(s, clock)
+	case clock == "":
+		fmt.Fprint(s, date)
+	default:
+		fmt.Fprint(s, strings.NewReplacer("{0}", clock, "{1}", date).Replace(
+			unquotePattern(data.dateTime[d.date]),
+		))
+	}
+}
+
+// Format a time with a CLDR pattern. Letters are fields, quoted text is
+// literal, unsupported fields are written as is.
+func (c *calendar) formatTime(t time.Time, pattern string) string {
+	var b strings.Builder
+
+	for n := 0; n < len(pattern); {
+		char := pattern[n]
+
+		if char == '\'' {
+			text, size := quotedText(pattern[n:])
+			b.WriteString(text)
+			n += size
+
+			continue
+		}
+
+		if (char < 'a' || char > 'z') && (char < 'A' || char > 'Z') {
+			b.WriteByte(char)
+			n++
+
+			continue
+		}
+
+		count := 1
+		for n+count < len(pattern) && pattern[n+count] == char {
+			count++
+		}
+
+		b.WriteString(c.formatField(t, char, count))
+		n += count
+	}
+
+	return b.String()
+}
+
+// Format a pattern field of count letters.
+func (c *calendar) formatField(t time.Time, char byte, count int) string {
+	switch char {
+	case 'y':
+		if count == 2 {
+			return pad(t.Year()%100, 2)
+		}
+
+		return pad(t.Year(), count)
+	case 'M', 'L':
+		months, monthsShort := c.months, c.monthsShort
+
+		if char == 'L' {
+			if c.standaloneMonths[0] != "" {
+				months = c.standaloneMonths
+			}
+
+			if c.standaloneMonthsShort[0] != "" {
+				monthsShort = c.standaloneMonthsShort
+			}
+		}
+
+		switch {
+		case count == 3:
+			return monthsShort[t.Month()-1]
+		case count > 3:
+			return months[t.Month()-1]
+		}
+
+		return pad(int(t.Month()), count)
+	case 'd':
+		return pad(t.Day(), count)
+	case 'E', 'c':
+		if count > 3 {
+			return c.weekdays[t.Weekday()]
+		}
+
+		return c.weekdaysShort[t.Weekday()]
+	case 'a', 'b', 'B':
+		// Day periods `b` and `B`, e.g. noon or in the morning, are written
+		// as AM and PM.
+		return c.dayPeriods[t.Hour()/12]
+	case 'h':
+		hour := t.Hour() % 12
+		if hour == 0 {
+			hour = 12
+		}
+
+		return pad(hour, count)
+	case 'H':
+		return pad(t.Hour(), count)
+	case 'm':
+		return pad(t.Minute(), count)
+	case 's':
+		return pad(t.Second(), count)
+	case 'z':
+		zone, _ := t.Zone()
+
+		return zone
+	}
+
+	return strings.Repeat(string(char), count)
+}
+
+// Get a quoted text at the start of a pattern and the size of the quoted
+// part, two quotes are a quote.
+func quotedText(s string) (string, int) {
+	if strings.HasPrefix(s, "''") {
+		return "'", 2
+	}
+
+	end := strings.IndexByte(s[1:], '\'')
+	if end < 0 {
+		return s[1:], len(s)
+	}
+
+	return strings.ReplaceAll(s[1:end+1], "''", "'"), end + 2
+}
+
+// Remove quotes of a date time combination pattern.
+func unquotePattern(s string) string {
+	var b strings.Builder
+
+	for n := 0; n < len(s); {
+		if s[n] != '\'' {
+			b.WriteByte(s[n])
+			n++
+
+			continue
+		}
+
+		text, size := quotedText(s[n:])
+		b.WriteString(text)
+		n += size
+	}
+
+	return b.String()
+}
+
+// Format a number with leading zeros to the width.
+func pad(v, width int) string {
+	s := strconv.Itoa(v)
+	if len(s) < width {
+		s = strings.Repeat("0", width-len(s)) + s
+	}
+
+	return s
+}
+
+// Duration relative to now.
+type relativeTime time.Duration
+
+// Relative time units from the largest.
+var relativeUnits = []struct {
+	name     string
+	duration time.Duration
+}{
+	{name: "year", duration: 365 * 24 * time.Hour},
+	{name: "month", duration: 30 * 24 * time.Hour},
+	{name: "week", duration: 7 * 24 * time.Hour},
+	{name: "day", duration: 24 * time.Hour},
+	{name: "hour", duration: time.Hour},
+	{name: "minute", duration: time.Minute},
+	{name: "second", duration: time.Second},
+}
+
+// Format the duration with the printer language.
+func (r relativeTime) Format(s fmt.State, _ rune) {
+	tag := stateLanguage(s)
+	data := calendarData(tag)
+	d := time.Duration(r)
+
+	if d > -time.Second && d < time.Second {
+		fmt.Fprint(s, data.relative.now)
+		return
+	}
+
+	abs := time.Duration(math.Abs(float64(d)))
+
+	for _, unit := range relativeUnits {
+		if abs < unit.duration {
+			continue
+		}
+
+		count := int(abs / unit.duration)
+
+		forms := data.relative.future[unit.name]
+		if d < 0 {
+			forms = data.relative.past[unit.name]
+		}
+
+		fmt.Fprint(s, strings.Replace(
+			pluralForm(forms, pluralCategory(tag, count, 0, 0)),
+			"{0}",
+			numberPrinter(tag).Sprint(number.Decimal(count)),
+			1,
+		))
+
+		return
+	}
+}
+
+// Get calendar data of the language, see FormattingOf.
+func calendarData(tag language.Tag) *calendar {
+	key, ok := localeKey(tag, func(key string) bool {
+		_, ok := calendars[key]
+		return ok
+	})
+	if !ok {
+		key = "en"
+	}
+
+	return calendars[key]
+}
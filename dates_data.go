@@ -0,0 +1,585 @@
+package i18n
+
+// Calendar data of the Gregorian calendar from CLDR by language. Month
+// names are of the format context, e.g. genitive in Russian, and of the
+// stand-alone context, e.g. nominative.
+var calendars = map[string]*calendar{
+	"en": {
+		months: [12]string{
+			"January", "February", "March", "April", "May", "June",
+			"July", "August", "September", "October", "November", "December",
+		},
+		monthsShort: [12]string{
+			"Jan", "Feb", "Mar", "Apr", "May", "Jun",
+			"Jul", "Aug", "Sep", "Oct", "Nov", "Dec",
+		},
+		weekdays:      [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		weekdaysShort: [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		dayPeriods:    [2]string{"AM", "PM"},
+		date:          [4]string{"M/d/yy", "MMM d, y", "MMMM d, y", "EEEE, MMMM d, y"},
+		time:          [4]string{"h:mm a", "h:mm:ss a", "h:mm:ss a z", "h:mm:ss a zzzz"},
+		dateTime:      [4]string{"{1}, {0}", "{1}, {0}", "{1} 'at' {0}", "{1} 'at' {0}"},
+		relative: relativeData{
+			now: "now",
+			future: map[string]map[string]string{
+				"year":   {"one": "in {0} year", "other": "in {0} years"},
+				"month":  {"one": "in {0} month", "other": "in {0} months"},
+				"week":   {"one": "in {0} week", "other": "in {0} weeks"},
+				"day":    {"one": "in {0} day", "other": "in {0} days"},
+				"hour":   {"one": "in {0} hour", "other": "in {0} hours"},
+				"minute": {"one": "in {0} minute", "other": "in {0} minutes"},
+				"second": {"one": "in {0} second", "other": "in {0} seconds"},
+			},
+			past: map[string]map[string]string{
+				"year":   {"one": "{0} year ago", "other": "{0} years ago"},
+				"month":  {"one": "{0} month ago", "other": "{0} months ago"},
+				"week":   {"one": "{0} week ago", "other": "{0} weeks ago"},
+				"day":    {"one": "{0} day ago", "other": "{0} days ago"},
+				"hour":   {"one": "{0} hour ago", "other": "{0} hours ago"},
+				"minute": {"one": "{0} minute ago", "other": "{0} minutes ago"},
+				"second": {"one": "{0} second ago", "other": "{0} seconds ago"},
+			},
+		},
+	},
+	"de": {
+		months: [12]string{
+			"Januar", "Februar", "März", "April", "Mai", "Juni",
+			"Juli", "August", "September", "Oktober", "November", "Dezember",
+		},
+		monthsShort: [12]string{
+			"Jan.", "Feb.", "März", "Apr.", "Mai", "Juni",
+			"Juli", "Aug.", "Sept.", "Okt.", "Nov.", "Dez.",
+		},
+		standaloneMonthsShort: [12]string{
+			"Jan", "Feb", "Mär", "Apr", "Mai", "Jun",
+			"Jul", "Aug", "Sep", "Okt", "Nov", "Dez",
+		},
+		weekdays:      [7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+		weekdaysShort: [7]string{"So.", "Mo.", "Di.", "Mi.", "Do.", "Fr.", "Sa."},
+		dayPeriods:    [2]string{"AM", "PM"},
+		date:          [4]string{"dd.MM.yy", "dd.MM.y", "d. MMMM y", "EEEE, d. MMMM y"},
+		time:          [4]string{"HH:mm", "HH:mm:ss", "HH:mm:ss z", "HH:mm:ss zzzz"},
+		dateTime:      [4]string{"{1}, {0}", "{1}, {0}", "{1} 'um' {0}", "{1} 'um' {0}"},
+		relative: relativeData{
+			now: "jetzt",
+			future: map[string]map[string]string{
+				"year":   {"one": "in {0} Jahr", "other": "in {0} Jahren"},
+				"month":  {"one": "in {0} Monat", "other": "in {0} Monaten"},
+				"week":   {"one": "in {0} Woche", "other": "in {0} Wochen"},
+				"day":    {"one": "in {0} Tag", "other": "in {0} Tagen"},
+				"hour":   {"one": "in {0} Stunde", "other": "in {0} Stunden"},
+				"minute": {"one": "in {0} Minute", "other": "in {0} Minuten"},
+				"second": {"one": "in {0} Sekunde", "other": "in {0} Sekunden"},
+			},
+			past: map[string]map[string]string{
+				"year":   {"one": "vor {0} Jahr", "other": "vor {0} Jahren"},
+				"month":  {"one": "vor {0} Monat", "other": "vor {0} Monaten"},
+				"week":   {"one": "vor {0} Woche", "other": "vor {0} Wochen"},
+				"day":    {"one": "vor {0} Tag", "other": "vor {0} Tagen"},
+				"hour":   {"one": "vor {0} Stunde", "other": "vor {0} Stunden"},
+				"minute": {"one": "vor {0} Minute", "other": "vor {0} Minuten"},
+				"second": {"one": "vor {0} Sekunde", "other": "vor {0} Sekunden"},
+			},
+		},
+	},
+	"es": {
+		months: [12]string{
+			"enero", "febrero", "marzo", "abril", "mayo", "junio",
+			"julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre",
+		},
+		monthsShort: [12]string{
+			"ene", "feb", "mar", "abr", "may", "jun",
+			"jul", "ago", "sept", "oct", "nov", "dic",
+		},
+		weekdays:      [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+		weekdaysShort: [7]string{"dom", "lun", "mar", "mié", "jue", "vie", "sáb"},
+		dayPeriods:    [2]string{"a. m.", "p. m."},
+		date:          [4]string{"d/M/yy", "d MMM y", "d 'de' MMMM 'de' y", "EEEE, d 'de' MMMM 'de' y"},
+		time:          [4]string{"H:mm", "H:mm:ss", "H:mm:ss z", "H:mm:ss (zzzz)"},
+		dateTime:      [4]string{"{1}, {0}", "{1}, {0}", "{1}, {0}", "{1}, {0}"},
+		relative: relativeData{
+			now: "ahora",
+			future: map[string]map[string]string{
+				"year":   {"one": "dentro de {0} año", "other": "dentro de {0} años"},
+				"month":  {"one": "dentro de {0} mes", "other": "dentro de {0} meses"},
+				"week":   {"one": "dentro de {0} semana", "other": "dentro de {0} semanas"},
+				"day":    {"one": "dentro de {0} día", "other": "dentro de {0} días"},
+				"hour":   {"one": "dentro de {0} hora", "other": "dentro de {0} horas"},
+				"minute": {"one": "dentro de {0} minuto", "other": "dentro de {0} minutos"},
+				"second": {"one": "dentro de {0} segundo", "other": "dentro de {0} segundos"},
+			},
+			past: map[string]map[string]string{
+				"year":   {"one": "hace {0} año", "other": "hace {0} años"},
+				"month":  {"one": "hace {0} mes", "other": "hace {0} meses"},
+				"week":   {"one": "hace {0} semana", "other": "hace {0} semanas"},
+				"day":    {"one": "hace {0} día", "other": "hace {0} días"},
+				"hour":   {"one": "hace {0} hora", "other": "hace {0} horas"},
+				"minute": {"one": "hace {0} minuto", "other": "hace {0} minutos"},
+				"second": {"one": "hace {0} segundo", "other": "hace {0} segundos"},
+			},
+		},
+	},
+	"fr": {
+		months: [12]string{
+			"janvier", "février", "mars", "avril", "mai", "juin",
+			"juillet", "août", "septembre", "octobre", "novembre", "décembre",
+		},
+		monthsShort: [12]string{
+			"janv.", "févr.", "mars", "avr.", "mai", "juin",
+			"juil.", "août", "sept.", "oct.", "nov.", "déc.",
+		},
+		weekdays:      [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+		weekdaysShort: [7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."},
+		dayPeriods:    [2]string{"AM", "PM"},
+		date:          [4]string{"dd/MM/y", "d MMM y", "d MMMM y", "EEEE d MMMM y"},
+		time:          [4]string{"HH:mm", "HH:mm:ss", "HH:mm:ss z", "HH:mm:ss zzzz"},
+		dateTime:      [4]string{"{1} {0}", "{1}, {0}", "{1} 'à' {0}", "{1} 'à' {0}"},
+		relative: relativeData{
+			now: "maintenant",
+			future: map[string]map[string]string{
+				"year":   {"one": "dans {0} an", "other": "dans {0} ans"},
+				"month":  {"one": "dans {0} mois", "other": "dans {0} mois"},
+				"week":   {"one": "dans {0} semaine", "other": "dans {0} semaines"},
+				"day":    {"one": "dans {0} jour", "other": "dans {0} jours"},
+				"hour":   {"one": "dans {0} heure", "other": "dans {0} heures"},
+				"minute": {"one": "dans {0} minute", "other": "dans {0} minutes"},
+				"second": {"one": "dans {0} seconde", "other": "dans {0} secondes"},
+			},
+			past: map[string]map[string]string{
+				"year":   {"one": "il y a {0} an", "other": "il y a {0} ans"},
+				"month":  {"one": "il y a {0} mois", "other": "il y a {0} mois"},
+				"week":   {"one": "il y a {0} semaine", "other": "il y a {0} semaines"},
+				"day":    {"one": "il y a {0} jour", "other": "il y a {0} jours"},
+				"hour":   {"one": "il y a {0} heure", "other": "il y a {0} heures"},
+				"minute": {"one": "il y a {0} minute", "other": "il y a {0} minutes"},
+				"second": {"one": "il y a {0} seconde", "other": "il y a {0} secondes"},
+			},
+		},
+	},
+	"it": {
+		months: [12]string{
+			"gennaio", "febbraio", "marzo", "aprile", "maggio", "giugno",
+			"luglio", "agosto", "settembre", "ottobre", "novembre", "dicembre",
+		},
+		monthsShort: [12]string{
+			"gen", "feb", "mar", "apr", "mag", "giu",
+			"lug", "ago", "set", "ott", "nov", "dic",
+		},
+		weekdays:      [7]string{"domenica", "lunedì", "martedì", "mercoledì", "giovedì", "venerdì", "sabato"},
+		weekdaysShort: [7]string{"dom", "lun", "mar", "mer", "gio", "ven", "sab"},
+		dayPeriods:    [2]string{"AM", "PM"},
+		date:          [4]string{"dd/MM/yy", "d MMM y", "d MMMM y", "EEEE d MMMM y"},
+		time:          [4]string{"HH:mm", "HH:mm:ss", "HH:mm:ss z", "HH:mm:ss zzzz"},
+		dateTime:      [4]string{"{1}, {0}", "{1}, {0}", "{1} {0}", "{1} {0}"},
+		relative: relativeData{
+			now: "ora",
+			future: map[string]map[string]string{
+				"year":   {"one": "tra {0} anno", "other": "tra {0} anni"},
+				"month":  {"one": "tra {0} mese", "other": "tra {0} mesi"},
+				"week":   {"one": "tra {0} settimana", "other": "tra {0} settimane"},
+				"day":    {"one": "tra {0} giorno", "other": "tra {0} giorni"},
+				"hour":   {"one": "tra {0} ora", "other": "tra {0} ore"},
+				"minute": {"one": "tra {0} minuto", "other": "tra {0} minuti"},
+				"second": {"one": "tra {0} secondo", "other": "tra {0} secondi"},
+			},
+			past: map[string]map[string]string{
+				"year":   {"one": "{0} anno fa", "other": "{0} anni fa"},
+				"month":  {"one": "{0} mese fa", "other": "{0} mesi fa"},
+				"week":   {"one": "{0} settimana fa", "other": "{0} settimane fa"},
+				"day":    {"one": "{0} giorno fa", "other": "{0} giorni fa"},
+				"hour":   {"one": "{0} ora fa", "other": "{0} ore fa"},
+				"minute": {"one": "{0} minuto fa", "other": "{0} minuti fa"},
+				"second": {"one": "{0} secondo fa", "other": "{0} secondi fa"},
+			},
+		},
+	},
+	"ja": {
+		months: [12]string{
+			"1月", "2月", "3月", "4月", "5月", "6月",
+			"7月", "8月", "9月", "10月", "11月", "12月",
+		},
+		monthsShort: [12]string{
+			"1月", "2月", "3月", "4月", "5月", "6月",
+			"7月", "8月", "9月", "10月", "11月", "12月",
+		},
+		weekdays:      [7]string{"日曜日", "月曜日", "火曜日", "水曜日", "木曜日", "金曜日", "土曜日"},
+		weekdaysShort: [7]string{"日", "月", "火", "水", "木", "金", "土"},
+		dayPeriods:    [2]string{"午前", "午後"},
+		date:          [4]string{"y/MM/dd", "y/MM/dd", "y年M月d日", "y年M月d日EEEE"},
+		time:          [4]string{"H:mm", "H:mm:ss", "H:mm:ss z", "H時mm分ss秒 zzzz"},
+		dateTime:      [4]string{"{1} {0}", "{1} {0}", "{1} {0}", "{1} {0}"},
+		relative: relativeData{
+			now: "今",
+			future: map[string]map[string]string{
+				"year":   {"other": "{0} 年後"},
+				"month":  {"other": "{0} か月後"},
+				"week":   {"other": "{0} 週間後"},
+				"day":    {"other": "{0} 日後"},
+				"hour":   {"other": "{0} 時間後"},
+				"minute": {"other": "{0} 分後"},
+				"second": {"other": "{0} 秒後"},
+			},
+			past: map[string]map[string]string{
+				"year":   {"other": "{0} 年前"},
+				"month":  {"other": "{0} か月前"},
+				"week":   {"other": "{0} 週間前"},
+				"day":    {"other": "{0} 日前"},
+				"hour":   {"other": "{0} 時間前"},
+				"minute": {"other": "{0} 分前"},
+				"second": {"other": "{0} 秒前"},
+			},
+		},
+	},
+	"ko": {
+		months: [12]string{
+			"1월", "2월", "3월", "4월", "5월", "6월",
+			"7월", "8월", "9월", "10월", "11월", "12월",
+		},
+		monthsShort: [12]string{
+			"1월", "2월", "3월", "4월", "5월", "6월",
+			"7월", "8월", "9월", "10월", "11월", "12월",
+		},
+		weekdays:      [7]string{"일요일", "월요일", "화요일", "수요일", "목요일", "금요일", "토요일"},
+		weekdaysShort: [7]string{"일", "월", "화", "수", "목", "금", "토"},
+		dayPeriods:    [2]string{"오전", "오후"},
+		date:          [4]string{"yy. M. d.", "y. M. d.", "y년 MMMM d일", "y년 MMMM d일 EEEE"},
+		time:          [4]string{"a h:mm", "a h:mm:ss", "a h시 m분 s초 z", "a h시 m분 s초 zzzz"},
+		dateTime:      [4]string{"{1} {0}", "{1} {0}", "{1} {0}", "{1} {0}"},
+		relative: relativeData{
+			now: "지금",
+			future: map[string]map[string]string{
+				"year":   {"other": "{0}년 후"},
+				"month":  {"other": "{0}개월 후"},
+				"week":   {"other": "{0}주 후"},
+				"day":    {"other": "{0}일 후"},
+				"hour":   {"other": "{0}시간 후"},
+				"minute": {"other": "{0}분 후"},
+				"second": {"other": "{0}초 후"},
+			},
+			past: map[string]map[string]string{
+				"year":   {"other": "{0}년 전"},
+				"month":  {"other": "{0}개월 전"},
+				"week":   {"other": "{0}주 전"},
+				"day":    {"other": "{0}일 전"},
+				"hour":   {"other": "{0}시간 전"},
+				"minute": {"other": "{0}분 전"},
+				"second": {"other": "{0}초 전"},
+			},
+		},
+	},
+	"nl": {
+		months: [12]string{
+			"januari", "februari", "maart", "april", "mei", "juni",
+			"juli", "augustus", "september", "oktober", "november", "december",
+		},
+		monthsShort: [12]string{
+			"jan", "feb", "mrt", "apr", "mei", "jun",
+			"jul", "aug", "sep", "okt", "nov", "dec",
+		},
+		weekdays:      [7]string{"zondag", "maandag", "dinsdag", "woensdag", "donderdag", "vrijdag", "zaterdag"},
+		weekdaysShort: [7]string{"zo", "ma", "di", "wo", "do", "vr", "za"},
+		dayPeriods:    [2]string{"a.m.", "p.m."},
+		date:          [4]string{"dd-MM-y", "d MMM y", "d MMMM y", "EEEE d MMMM y"},
+		time:          [4]string{"HH:mm", "HH:mm:ss", "HH:mm:ss z", "HH:mm:ss zzzz"},
+		dateTime:      [4]string{"{1} {0}", "{1} {0}", "{1} 'om' {0}", "{1} 'om' {0}"},
+		relative: relativeData{
+			now: "nu",
+			future: map[string]map[string]string{
+				"year":   {"one": "over {0} jaar", "other": "over {0} jaar"},
+				"month":  {"one": "over {0} maand", "other": "over {0} maanden"},
+				"week":   {"one": "over {0} week", "other": "over {0} weken"},
+				"day":    {"one": "over {0} dag", "other": "over {0} dagen"},
+				"hour":   {"one": "over {0} uur", "other": "over {0} uur"},
+				"minute": {"one": "over {0} minuut", "other": "over {0} minuten"},
+				"second": {"one": "over {0} seconde", "other": "over {0} seconden"},
+			},
+			past: map[string]map[string]string{
+				"year":   {"one": "{0} jaar geleden", "other": "{0} jaar geleden"},
+				"month":  {"one": "{0} maand geleden", "other": "{0} maanden geleden"},
+				"week":   {"one": "{0} week geleden", "other": "{0} weken geleden"},
+				"day":    {"one": "{0} dag geleden", "other": "{0} dagen geleden"},
+				"hour":   {"one": "{0} uur geleden", "other": "{0} uur geleden"},
+				"minute": {"one": "{0} minuut geleden", "other": "{0} minuten geleden"},
+				"second": {"one": "{0} seconde geleden", "other": "{0} seconden geleden"},
+			},
+		},
+	},
+	"pl": {
+		months: [12]string{
+			"stycznia", "lutego", "marca", "kwietnia", "maja", "czerwca",
+			"lipca", "sierpnia", "września", "października", "listopada", "grudnia",
+		},
+		monthsShort: [12]string{
+			"sty", "lut", "mar", "kwi", "maj", "cze",
+			"lip", "sie", "wrz", "paź", "lis", "gru",
+		},
+		standaloneMonths: [12]string{
+			"styczeń", "luty", "marzec", "kwiecień", "maj", "czerwiec",
+			"lipiec", "sierpień", "wrzesień", "październik", "listopad", "grudzień",
+		},
+		weekdays:      [7]string{"niedziela", "poniedziałek", "wtorek", "środa", "czwartek", "piątek", "sobota"},
+		weekdaysShort: [7]string{"niedz.", "pon.", "wt.", "śr.", "czw.", "pt.", "sob."},
+		dayPeriods:    [2]string{"AM", "PM"},
+		date:          [4]string{"d.MM.y", "d MMM y", "d MMMM y", "EEEE, d MMMM y"},
+		time:          [4]string{"HH:mm", "HH:mm:ss", "HH:mm:ss z", "HH:mm:ss zzzz"},
+		dateTime:      [4]string{"{1}, {0}", "{1}, {0}", "{1} {0}", "{1} {0}"},
+		relative: relativeData{
+			now: "teraz",
+			future: map[string]map[string]string{
+				"year":   {"one": "za {0} rok", "few": "za {0} lata", "many": "za {0} lat", "other": "za {0} roku"},
+				"month":  {"one": "za {0} miesiąc", "few": "za {0} miesiące", "many": "za {0} miesięcy", "other": "za {0} miesiąca"},
+				"week":   {"one": "za {0} tydzień", "few": "za {0} tygodnie", "many": "za {0} tygodni", "other": "za {0} tygodnia"},
+				"day":    {"one": "za {0} dzień", "few": "za {0} dni", "many": "za {0} dni", "other": "za {0} dnia"},
+				"hour":   {"one": "za {0} godzinę", "few": "za {0} godziny", "many": "za {0} godzin", "other": "za {0} godziny"},
+				"minute": {"one": "za {0} minutę", "few": "za {0} minuty", "many": "za {0} minut", "other": "za {0} minuty"},
+				"second": {"one": "za {0} sekundę", "few": "za {0} sekundy", "many": "za {0} sekund", "other": "za {0} sekundy"},
+			},
+			past: map[string]map[string]string{
+				"year":   {"one": "{0} rok temu", "few": "{0} lata temu", "many": "{0} lat temu", "other": "{0} roku temu"},
+				"month":  {"one": "{0} miesiąc temu", "few": "{0} miesiące temu", "many": "{0} miesięcy temu", "other": "{0} miesiąca temu"},
+				"week":   {"one": "{0} tydzień temu", "few": "{0} tygodnie temu", "many": "{0} tygodni temu", "other": "{0} tygodnia temu"},
+				"day":    {"one": "{0} dzień temu", "few": "{0} dni temu", "many": "{0} dni temu", "other": "{0} dnia temu"},
+				"hour":   {"one": "{0} godzinę temu", "few": "{0} godziny temu", "many": "{0} godzin temu", "other": "{0} godziny temu"},
+				"minute": {"one": "{0} minutę temu", "few": "{0} minuty temu", "many": "{0} minut temu", "other": "{0} minuty temu"},
+				"second": {"one": "{0} sekundę temu", "few": "{0} sekundy temu", "many": "{0} sekund temu", "other": "{0} sekundy temu"},
+			},
+		},
+	},
+	"pt": {
+		months: [12]string{
+			"janeiro", "fevereiro", "março", "abril", "maio", "junho",
+			"julho", "agosto", "setembro", "outubro", "novembro", "dezembro",
+		},
+		monthsShort: [12]string{
+			"jan.", "fev.", "mar.", "abr.", "mai.", "jun.",
+			"jul.", "ago.", "set.", "out.", "nov.", "dez.",
+		},
+		weekdays: [7]string{
+			"domingo", "segunda-feira", "terça-feira", "quarta-feira",
+			"quinta-feira", "sexta-feira", "sábado",
+		},
+		weekdaysShort: [7]string{"dom.", "seg.", "ter.", "qua.", "qui.", "sex.", "sáb."},
+		dayPeriods:    [2]string{"AM", "PM"},
+		date:          [4]string{"dd/MM/y", "d 'de' MMM 'de' y", "d 'de' MMMM 'de' y", "EEEE, d 'de' MMMM 'de' y"},
+		time:          [4]string{"HH:mm", "HH:mm:ss", "HH:mm:ss z", "HH:mm:ss zzzz"},
+		dateTime:      [4]string{"{1} {0}", "{1} {0}", "{1} {0}", "{1} {0}"},
+		relative: relativeData{
+			now: "agora",
+			future: map[string]map[string]string{
+				"year":   {"one": "em {0} ano", "other": "em {0} anos"},
+				"month":  {"one": "em {0} mês", "other": "em {0} meses"},
+				"week":   {"one": "em {0} semana", "other": "em {0} semanas"},
+				"day":    {"one": "em {0} dia", "other": "em {0} dias"},
+				"hour":   {"one": "em {0} hora", "other": "em {0} horas"},
+				"minute": {"one": "em {0} minuto", "other": "em {0} minutos"},
+				"second": {"one": "em {0} segundo", "other": "em {0} segundos"},
+			},
+			past: map[string]map[string]string{
+				"year":   {"one": "há {0} ano", "other": "há {0} anos"},
+				"month":  {"one": "há {0} mês", "other": "há {0} meses"},
+				"week":   {"one": "há {0} semana", "other": "há {0} semanas"},
+				"day":    {"one": "há {0} dia", "other": "há {0} dias"},
+				"hour":   {"one": "há {0} hora", "other": "há {0} horas"},
+				"minute": {"one": "há {0} minuto", "other": "há {0} minutos"},
+				"second": {"one": "há {0} segundo", "other": "há {0} segundos"},
+			},
+		},
+	},
+	"ru": {
+		months: [12]string{
+			"января", "февраля", "марта", "апреля", "мая", "июня",
+			"июля", "августа", "сентября", "октября", "ноября", "декабря",
+		},
+		monthsShort: [12]string{
+			"янв.", "февр.", "мар.", "апр.", "мая", "июн.",
+			"июл.", "авг.", "сент.", "окт.", "нояб.", "дек.",
+		},
+		standaloneMonths: [12]string{
+			"январь", "февраль", "март", "апрель", "май", "июнь",
+			"июль", "август", "сентябрь", "октябрь", "ноябрь", "декабрь",
+		},
+		standaloneMonthsShort: [12]string{
+			"янв.", "февр.", "март", "апр.", "май", "июнь",
+			"июль", "авг.", "сент.", "окт.", "нояб.", "дек.",
+		},
+		weekdays:      [7]string{"воскресенье", "понедельник", "вторник", "среда", "четверг", "пятница", "суббота"},
+		weekdaysShort: [7]string{"вс", "пн", "вт", "ср", "чт", "пт", "сб"},
+		dayPeriods:    [2]string{"AM", "PM"},
+		date:          [4]string{"dd.MM.y", "d MMM y 'г'.", "d MMMM y 'г'.", "EEEE, d MMMM y 'г'."},
+		time:          [4]string{"HH:mm", "HH:mm:ss", "HH:mm:ss z", "HH:mm:ss zzzz"},
+		dateTime:      [4]string{"{1}, {0}", "{1}, {0}", "{1}, {0}", "{1}, {0}"},
+		relative: relativeData{
+			now: "сейчас",
+			future: map[string]map[string]string{
+				"year":   {"one": "через {0} год", "few": "через {0} года", "many": "через {0} лет", "other": "через {0} года"},
+				"month":  {"one": "через {0} месяц", "few": "через {0} месяца", "many": "через {0} месяцев", "other": "через {0} месяца"},
+				"week":   {"one": "через {0} неделю", "few": "через {0} недели", "many": "через {0} недель", "other": "через {0} недели"},
+				"day":    {"one": "через {0} день", "few": "через {0} дня", "many": "через {0} дней", "other": "через {0} дня"},
+				"hour":   {"one": "через {0} час", "few": "через {0} часа", "many": "через {0} часов", "other": "через {0} часа"},
+				"minute": {"one": "через {0} минуту", "few": "через {0} минуты", "many": "через {0} минут", "other": "через {0} минуты"},
+				"second": {"one": "через {0} секунду", "few": "через {0} секунды", "many": "через {0} секунд", "other": "через {0} секунды"},
+			},
+			past: map[string]map[string]string{
+				"year":   {"one": "{0} год назад", "few": "{0} года назад", "many": "{0} лет назад", "other": "{0} года назад"},
+				"month":  {"one": "{0} месяц назад", "few": "{0} месяца назад", "many": "{0} месяцев назад", "other": "{0} месяца назад"},
+				"week":   {"one": "{0} неделю назад", "few": "{0} недели назад", "many": "{0} недель назад", "other": "{0} недели назад"},
+				"day":    {"one": "{0} день назад", "few": "{0} дня назад", "many": "{0} дней назад", "other": "{0} дня назад"},
+				"hour":   {"one": "{0} час назад", "few": "{0} часа назад", "many": "{0} часов назад", "other": "{0} часа назад"},
+				"minute": {"one": "{0} минуту назад", "few": "{0} минуты назад", "many": "{0} минут назад", "other": "{0} минуты назад"},
+				"second": {"one": "{0} секунду назад", "few": "{0} секунды назад", "many": "{0} секунд назад", "other": "{0} секунды назад"},
+			},
+		},
+	},
+	"uk": {
+		months: [12]string{
+			"січня", "лютого", "березня", "квітня", "травня", "червня",
+			"липня", "серпня", "вересня", "жовтня", "листопада", "грудня",
+		},
+		monthsShort: [12]string{
+			"січ.", "лют.", "бер.", "квіт.", "трав.", "черв.",
+			"лип.", "серп.", "вер.", "жовт.", "лист.", "груд.",
+		},
+		standaloneMonths: [12]string{
+			"січень", "лютий", "березень", "квітень", "травень", "червень",
+			"липень", "серпень", "вересень", "жовтень", "листопад", "грудень",
+		},
+		weekdays:      [7]string{"неділя", "понеділок", "вівторок", "середа", "четвер", "пʼятниця", "субота"},
+		weekdaysShort: [7]string{"нд", "пн", "вт", "ср", "чт", "пт", "сб"},
+		dayPeriods:    [2]string{"дп", "пп"},
+		date:          [4]string{"dd.MM.yy", "d MMM y 'р'.", "d MMMM y 'р'.", "EEEE, d MMMM y 'р'."},
+		time:          [4]string{"HH:mm", "HH:mm:ss", "HH:mm:ss z", "HH:mm:ss zzzz"},
+		dateTime:      [4]string{"{1}, {0}", "{1}, {0}", "{1} 'о' {0}", "{1} 'о' {0}"},
+		relative: relativeData{
+			now: "зараз",
+			future: map[string]map[string]string{
+				"year":   {"one": "через {0} рік", "few": "через {0} роки", "many": "через {0} років", "other": "через {0} року"},
+				"month":  {"one": "через {0} місяць", "few": "через {0} місяці", "many": "через {0} місяців", "other": "через {0} місяця"},
+				"week":   {"one": "через {0} тиждень", "few": "через {0} тижні", "many": "через {0} тижнів", "other": "через {0} тижня"},
+				"day":    {"one": "через {0} день", "few": "через {0} дні", "many": "через {0} днів", "other": "через {0} дня"},
+				"hour":   {"one": "через {0} годину", "few": "через {0} години", "many": "через {0} годин", "other": "через {0} години"},
+				"minute": {"one": "через {0} хвилину", "few": "через {0} хвилини", "many": "через {0} хвилин", "other": "через {0} хвилини"},
+				"second": {"one": "через {0} секунду", "few": "через {0} секунди", "many": "через {0} секунд", "other": "через {0} секунди"},
+			},
+			past: map[string]map[string]string{
+				"year":   {"one": "{0} рік тому", "few": "{0} роки тому", "many": "{0} років тому", "other": "{0} року тому"},
+				"month":  {"one": "{0} місяць тому", "few": "{0} місяці тому", "many": "{0} місяців тому", "other": "{0} місяця тому"},
+				"week":   {"one": "{0} тиждень тому", "few": "{0} тижні тому", "many": "{0} тижнів тому", "other": "{0} тижня тому"},
+				"day":    {"one": "{0} день тому", "few": "{0} дні тому", "many": "{0} днів тому", "other": "{0} дня тому"},
+				"hour":   {"one": "{0} годину тому", "few": "{0} години тому", "many": "{0} годин тому", "other": "{0} години тому"},
+				"minute": {"one": "{0} хвилину тому", "few": "{0} хвилини тому", "many": "{0} хвилин тому", "other": "{0} хвилини тому"},
+				"second": {"one": "{0} секунду тому", "few": "{0} секунди тому", "many": "{0} секунд тому", "other": "{0} секунди тому"},
+			},
+		},
+	},
+	"zh": {
+		months: [12]string{
+			"一月", "二月", "三月", "四月", "五月", "六月",
+			"七月", "八月", "九月", "十月", "十一月", "十二月",
+		},
+		monthsShort: [12]string{
+			"1月", "2月", "3月", "4月", "5月", "6月",
+			"7月", "8月", "9月", "10月", "11月", "12月",
+		},
+		weekdays:      [7]string{"星期日", "星期一", "星期二", "星期三", "星期四", "星期五", "星期六"},
+		weekdaysShort: [7]string{"周日", "周一", "周二", "周三", "周四", "周五", "周六"},
+		dayPeriods:    [2]string{"上午", "下午"},
+		date:          [4]string{"y/M/d", "y年M月d日", "y年M月d日", "y年M月d日EEEE"},
+		time:          [4]string{"HH:mm", "HH:mm:ss", "z HH:mm:ss", "zzzz HH:mm:ss"},
+		dateTime:      [4]string{"{1} {0}", "{1} {0}", "{1} {0}", "{1} {0}"},
+		relative: relativeData{
+			now: "现在",
+			future: map[string]map[string]string{
+				"year":   {"other": "{0}年后"},
+				"month":  {"other": "{0}个月后"},
+				"week":   {"other": "{0}周后"},
+				"day":    {"other": "{0}天后"},
+				"hour":   {"other": "{0}小时后"},
+				"minute": {"other": "{0}分钟后"},
+				"second": {"other": "{0}秒钟后"},
+			},
+			past: map[string]map[string]string{
+				"year":   {"other": "{0}年前"},
+				"month":  {"other": "{0}个月前"},
+				"week":   {"other": "{0}周前"},
+				"day":    {"other": "{0}天前"},
+				"hour":   {"other": "{0}小时前"},
+				"minute": {"other": "{0}分钟前"},
+				"second": {"other": "{0}秒钟前"},
+			},
+		},
+	},
+	"zh-Hant": {
+		months: [12]string{
+			"1月", "2月", "3月", "4月", "5月", "6月",
+			"7月", "8月", "9月", "10月", "11月", "12月",
+		},
+		monthsShort: [12]string{
+			"1月", "2月", "3月", "4月", "5月", "6月",
+			"7月", "8月", "9月", "10月", "11月", "12月",
+		},
+		weekdays:      [7]string{"星期日", "星期一", "星期二", "星期三", "星期四", "星期五", "星期六"},
+		weekdaysShort: [7]string{"週日", "週一", "週二", "週三", "週四", "週五", "週六"},
+		dayPeriods:    [2]string{"上午", "下午"},
+		date:          [4]string{"y/M/d", "y年M月d日", "y年M月d日", "y年M月d日 EEEE"},
+		time:          [4]string{"ah:mm", "ah:mm:ss", "ah:mm:ss [z]", "ah:mm:ss [zzzz]"},
+		dateTime:      [4]string{"{1} {0}", "{1} {0}", "{1} {0}", "{1} {0}"},
+		relative: relativeData{
+			now: "現在",
+			future: map[string]map[string]string{
+				"year":   {"other": "{0} 年後"},
+				"month":  {"other": "{0} 個月後"},
+				"week":   {"other": "{0} 週後"},
+				"day":    {"other": "{0} 天後"},
+				"hour":   {"other": "{0} 小時後"},
+				"minute": {"other": "{0} 分鐘後"},
+				"second": {"other": "{0} 秒後"},
+			},
+			past: map[string]map[string]string{
+				"year":   {"other": "{0} 年前"},
+				"month":  {"other": "{0} 個月前"},
+				"week":   {"other": "{0} 週前"},
+				"day":    {"other": "{0} 天前"},
+				"hour":   {"other": "{0} 小時前"},
+				"minute": {"other": "{0} 分鐘前"},
+				"second": {"other": "{0} 秒前"},
+			},
+		},
+	},
+	"ar": {
+		months: [12]string{
+			"يناير", "فبراير", "مارس", "أبريل", "مايو", "يونيو",
+			"يوليو", "أغسطس", "سبتمبر", "أكتوبر", "نوفمبر", "ديسمبر",
+		},
+		monthsShort: [12]string{
+			"يناير", "فبراير", "مارس", "أبريل", "مايو", "يونيو",
+			"يوليو", "أغسطس", "سبتمبر", "أكتوبر", "نوفمبر", "ديسمبر",
+		},
+		weekdays:      [7]string{"الأحد", "الاثنين", "الثلاثاء", "الأربعاء", "الخميس", "الجمعة", "السبت"},
+		weekdaysShort: [7]string{"الأحد", "الاثنين", "الثلاثاء", "الأربعاء", "الخميس", "الجمعة", "السبت"},
+		dayPeriods:    [2]string{"ص", "م"},
+		date:          [4]string{"d‏/M‏/y", "dd‏/MM‏/y", "d MMMM y", "EEEE، d MMMM y"},
+		time:          [4]string{"h:mm a", "h:mm:ss a", "h:mm:ss a z", "h:mm:ss a zzzz"},
+		dateTime:      [4]string{"{1}، {0}", "{1}، {0}", "{1} في {0}", "{1} في {0}"},
+		relative: relativeData{
+			now: "الآن",
+			future: map[string]map[string]string{
+				"year":   oneTwoFewMany("خلال سنة واحدة", "خلال سنتين", "خلال {0} سنوات", "خلال {0} سنة", "خلال {0} سنة"),
+				"month":  oneTwoFewMany("خلال شهر واحد", "خلال شهرين", "خلال {0} أشهر", "خلال {0} شهرًا", "خلال {0} شهر"),
+				"week":   oneTwoFewMany("خلال أسبوع واحد", "خلال أسبوعين", "خلال {0} أسابيع", "خلال {0} أسبوعًا", "خلال {0} أسبوع"),
+				"day":    oneTwoFewMany("خلال يوم واحد", "خلال يومين", "خلال {0} أيام", "خلال {0} يومًا", "خلال {0} يوم"),
+				"hour":   oneTwoFewMany("خلال ساعة واحدة", "خلال ساعتين", "خلال {0} ساعات", "خلال {0} ساعة", "خلال {0} ساعة"),
+				"minute": oneTwoFewMany("خلال دقيقة واحدة", "خلال دقيقتين", "خلال {0} دقائق", "خلال {0} دقيقة", "خلال {0} دقيقة"),
+				"second": oneTwoFewMany("خلال ثانية واحدة", "خلال ثانيتين", "خلال {0} ثوانٍ", "خلال {0} ثانية", "خلال {0} ثانية"),
+			},
+			past: map[string]map[string]string{
+				"year":   oneTwoFewMany("قبل سنة واحدة", "قبل سنتين", "قبل {0} سنوات", "قبل {0} سنة", "قبل {0} سنة"),
+				"month":  oneTwoFewMany("قبل شهر واحد", "قبل شهرين", "قبل {0} أشهر", "قبل {0} شهرًا", "قبل {0} شهر"),
+				"week":   oneTwoFewMany("قبل أسبوع واحد", "قبل أسبوعين", "قبل {0} أسابيع", "قبل {0} أسبوعًا", "قبل {0} أسبوع"),
+				"day":    oneTwoFewMany("قبل يوم واحد", "قبل يومين", "قبل {0} أيام", "قبل {0} يومًا", "قبل {0} يوم"),
+				"hour":   oneTwoFewMany("قبل ساعة واحدة", "قبل ساعتين", "قبل {0} ساعات", "قبل {0} ساعة", "قبل {0} ساعة"),
+				"minute": oneTwoFewMany("قبل دقيقة واحدة", "قبل دقيقتين", "قبل {0} دقائق", "قبل {0} دقيقة", "قبل {0} دقيقة"),
+				"second": oneTwoFewMany("قبل ثانية واحدة", "قبل ثانيتين", "قبل {0} ثوانٍ", "قبل {0} ثانية", "قبل {0} ثانية"),
+			},
+		},
+	},
+}
@@ -0,0 +1,122 @@
+package i18n
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+func Test_Dates(t *testing.T) {
+	t.Parallel()
+
+	date := time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC)
+
+	testCases := []struct {
+		name string
+		tag  language.Tag
+		arg  interface{}
+		out  string
+	}{
+		{name: "date short en", tag: language.English, arg: Date(date, Short), out: "1/2/06"},
+		{name: "date medium en", tag: language.English, arg: Date(date, Medium), out: "Jan 2, 2006"},
+		{name: "date full en", tag: language.English, arg: Date(date, Full), out: "Monday, January 2, 2006"},
+		{name: "date long de", tag: language.German, arg: Date(date, Long), out: "2. Januar 2006"},
+		{name: "date long ru", tag: language.Russian, arg: Date(date, Long), out: "2 января 2006 г."},
+		{name: "date long es", tag: language.Spanish, arg: Date(date, Long), out: "2 de enero de 2006"},
+		{name: "date full ja", tag: language.Japanese, arg: Date(date, Full), out: "2006年1月2日月曜日"},
+		{name: "time short en", tag: language.English, arg: Time(date, Short), out: "3:04\u202fPM"},
+		{name: "time long de", tag: language.German, arg: Time(date, Long), out: "15:04:05 UTC"},
+		{name: "time short ko", tag: language.Korean, arg: Time(date, Short), out: "오후 3:04"},
+		{name: "date time en", tag: language.English, arg: DateTime(date, Long, Short), out: "January 2, 2006 at 3:04\u202fPM"},
+		{name: "date time fr", tag: language.French, arg: DateTime(date, Long, Short), out: "2 janvier 2006 à 15:04"},
+		{name: "date full ar", tag: language.Arabic, arg: Date(date, Full), out: "الاثنين، 2 يناير 2006"},
+		{name: "date long zh-TW", tag: language.MustParse("zh-TW"), arg: Date(date, Full), out: "2006年1月2日 星期一"},
+		{name: "parent language", tag: language.BritishEnglish, arg: Date(date, Medium), out: "Jan 2, 2006"},
+		{name: "language without data", tag: language.Swahili, arg: Date(date, Medium), out: "Jan 2, 2006"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name,
+			func(t *testing.T) {
+				p := message.NewPrinter(tc.tag)
+
+				assert.Equal(t, tc.out, p.Sprint(tc.arg))
+			},
+		)
+	}
+}
+
+func Test_FormatTimeMonths(t *testing.T) {
+	t.Parallel()
+
+	date := time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC)
+
+	testCases := []struct {
+		tag     language.Tag
+		pattern string
+		out     string
+	}{
+		{tag: language.English, pattern: "LLLL y", out: "January 2006"},
+		{tag: language.Russian, pattern: "d MMMM", out: "2 января"},
+		{tag: language.Russian, pattern: "LLLL y", out: "январь 2006"},
+		{tag: language.Russian, pattern: "LLL", out: "янв."},
+		{tag: language.Polish, pattern: "LLLL", out: "styczeń"},
+		{tag: language.German, pattern: "MMM", out: "Jan."},
+		{tag: language.German, pattern: "LLL", out: "Jan"},
+		{tag: language.German, pattern: "LLLL", out: "Januar"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.tag.String()+" "+tc.pattern,
+			func(t *testing.T) {
+				assert.Equal(t, tc.out, calendarData(tc.tag).formatTime(date, tc.pattern))
+			},
+		)
+	}
+}
+
+func Test_RelativeTime(t *testing.T) {
+	t.Parallel()
+
+	day := 24 * time.Hour
+
+	testCases := []struct {
+		name string
+		tag  language.Tag
+		in   time.Duration
+		out  string
+	}{
+		{name: "now", tag: language.English, in: 0, out: "now"},
+		{name: "seconds", tag: language.English, in: 30 * time.Second, out: "in 30 seconds"},
+		{name: "minute ago", tag: language.English, in: -time.Minute, out: "1 minute ago"},
+		{name: "hours", tag: language.English, in: 2*time.Hour + 59*time.Minute, out: "in 2 hours"},
+		{name: "days ago", tag: language.English, in: -3 * day, out: "3 days ago"},
+		{name: "weeks", tag: language.English, in: 15 * day, out: "in 2 weeks"},
+		{name: "months", tag: language.English, in: 95 * day, out: "in 3 months"},
+		{name: "years", tag: language.English, in: -800 * day, out: "2 years ago"},
+		{name: "ru few", tag: language.Russian, in: 21 * day, out: "через 3 недели"},
+		{name: "ru many", tag: language.Russian, in: -5 * day, out: "5 дней назад"},
+		{name: "de", tag: language.German, in: -2 * time.Hour, out: "vor 2 Stunden"},
+		{name: "ja", tag: language.Japanese, in: 3 * day, out: "3 日後"},
+		{name: "ar two", tag: language.Arabic, in: -2 * day, out: "قبل يومين"},
+		{name: "ar few", tag: language.Arabic, in: 3 * time.Hour, out: "خلال ٣ ساعات"},
+		{name: "zh", tag: language.Chinese, in: time.Hour, out: "1小时后"},
+		{name: "zh-TW", tag: language.MustParse("zh-TW"), in: time.Hour, out: "1 小時後"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name,
+			func(t *testing.T) {
+				p := message.NewPrinter(tc.tag)
+
+				assert.Equal(t, tc.out, p.Sprint(RelativeTime(tc.in)))
+			},
+		)
+	}
+}
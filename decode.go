@@ -0,0 +1,179 @@
+package i18n
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Convert the file content to JSON according to the file extension.
+func toJSON(path string, b []byte) ([]byte, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return yamlToJSON(b)
+	default:
+		return b, nil
+	}
+}
+
+// Convert YAML to JSON.
+func yamlToJSON(b []byte) ([]byte, error) {
+	var v interface{}
+	if err := yaml.Unmarshal(b, &v); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(normalize(v))
+}
+
+// Convert YAML maps to JSON compatible maps with string keys.
+func normalize(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			v[key] = normalize(value)
+		}
+
+		return v
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			m[fmt.Sprint(key)] = normalize(value)
+		}
+
+		return m
+	case []interface{}:
+		for key, value := range v {
+			v[key] = normalize(value)
+		}
+
+		return v
+	default:
+		return v
+	}
+}
+
+// Decode JSON messages. Messages are either an array of message objects:
+//
+//	[{"id": "auth.login", "message": "Log in"}]
+//
+// or a flat or nested object, nested keys are joined with a dot:
+//
+//	{"auth": {"login": "Log in"}}
+//
+// An object with plural selectors as keys is treated as plural rules.
+func decodeMessages(b []byte) ([]translationMessage, error) {
+	if b = bytes.TrimSpace(b); len(b) > 0 && b[0] == '{' {
+		var v map[string]interface{}
+		if err := json.Unmarshal(b, &v); err != nil {
+			return nil, err
+		}
+
+		return flatten(v), nil
+	}
+
+	var m []translationMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// Flatten nested messages to messages with dotted ids, sorted by id.
+func flatten(v map[string]interface{}) []translationMessage {
+	var m []translationMessage
+
+	var walk func(prefix string, v map[string]interface{})
+	walk = func(prefix string, v map[string]interface{}) {
+		for key, value := range v {
+			id := key
+			if prefix != "" {
+				id = prefix + "." + key
+			}
+
+			switch value := value.(type) {
+			case map[string]interface{}:
+				if isRules(value) {
+					m = append(m, translationMessage{ID: id, Rules: value})
+				} else {
+					walk(id, value)
+				}
+			case string:
+				m = append(m, translationMessage{ID: id, Message: &value})
+			default:
+				// Keep the wrong value to report it while loading.
+				m = append(m, translationMessage{ID: id, Rules: value})
+			}
+		}
+	}
+
+	walk("", v)
+
+	sort.Slice(m, func(a, b int) bool { return m[a].ID < m[b].ID })
+
+	return m
+}
+
+// Check if the object contains plural rules, i.e. every key is a plural
+// selector, or an argument number with plural selectors.
+func isRules(v map[string]interface{}) bool {
+	if len(v) == 0 {
+		return false
+	}
+
+	for key, value := range v {
+		switch value := value.(type) {
+		case string:
+			if !isSelector(key) {
+				return false
+			}
+		case map[string]interface{}:
+			if n, err := strconv.Atoi(key); err != nil || n < 1 || !isRules(value) {
+				return false
+			}
+
+			for _, sub := range value {
+				if _, ok := sub.(string); !ok {
+					return false
+				}
+			}
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+// Check if the key is a plural selector.
+func isSelector(key string) bool {
+	switch key {
+	case "zero", "one", "two", "few", "many", "other":
+		return true
+	}
+
+	if len(key) < 2 || strings.IndexByte("=<>", key[0]) < 0 {
+		return false
+	}
+
+	_, err := strconv.Atoi(key[1:])
+
+	return err == nil
+}
+
+// Plural rules of the substitution argument, rules of the first argument are
+// not nested.
+func argumentRules(arg int, rules map[string]interface{}) map[string]interface{} {
+	if arg == 1 {
+		return rules
+	}
+
+	return map[string]interface{}{strconv.Itoa(arg): rules}
+}
@@ -0,0 +1,138 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ToJSON(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+		path string
+		data string
+		out  string
+		err  bool
+	}{
+		{
+			name: "json",
+			path: "en.json",
+			data: `[{"id": "id", "message": "message"}]`,
+			out:  `[{"id": "id", "message": "message"}]`,
+		},
+		{
+			name: "yaml",
+			path: "en.yaml",
+			data: "- id: id\n  message: message\n",
+			out:  `[{"id":"id","message":"message"}]`,
+		},
+		{
+			name: "yaml with numeric keys",
+			path: "en.yml",
+			data: "- id: id\n  rules:\n    1:\n      one: message\n",
+			out:  `[{"id":"id","rules":{"1":{"one":"message"}}}]`,
+		},
+		{
+			name: "wrong yaml",
+			path: "en.yaml",
+			data: "- id: [",
+			err:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name,
+			func(t *testing.T) {
+				b, err := toJSON(tc.path, []byte(tc.data))
+
+				if tc.err {
+					assert.Error(t, err)
+				} else {
+					assert.NoError(t, err)
+					assert.Equal(t, tc.out, string(b))
+				}
+			},
+		)
+	}
+}
+
+func Test_DecodeMessages(t *testing.T) {
+	t.Parallel()
+
+	message := func(s string) *string { return &s }
+
+	testCases := []struct {
+		name string
+		data string
+		out  []translationMessage
+		err  bool
+	}{
+		{
+			name: "array",
+			data: `[{"id": "hello", "message": "Hello"}]`,
+			out:  []translationMessage{{ID: "hello", Message: message("Hello")}},
+		},
+		{
+			name: "flat object",
+			data: `{"hello": "Hello", "bye": "Bye"}`,
+			out: []translationMessage{
+				{ID: "bye", Message: message("Bye")},
+				{ID: "hello", Message: message("Hello")},
+			},
+		},
+		{
+			name: "nested object",
+			data: `{"auth": {"login": "Log in", "errors": {"password": "Wrong password"}}}`,
+			out: []translationMessage{
+				{ID: "auth.errors.password", Message: message("Wrong password")},
+				{ID: "auth.login", Message: message("Log in")},
+			},
+		},
+		{
+			name: "nested rules",
+			data: `{"cart": {"items": {"one": "%d item", "other": "%d items"}}}`,
+			out: []translationMessage{
+				{
+					ID:    "cart.items",
+					Rules: map[string]interface{}{"one": "%d item", "other": "%d items"},
+				},
+			},
+		},
+		{
+			name: "nested rules with argument number",
+			data: `{"cart": {"2": {"=0": "empty", "other": "%d items"}}}`,
+			out: []translationMessage{
+				{
+					ID: "cart",
+					Rules: map[string]interface{}{
+						"2": map[string]interface{}{"=0": "empty", "other": "%d items"},
+					},
+				},
+			},
+		},
+		{
+			name: "wrong format",
+			data: `{"hello": `,
+			err:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name,
+			func(t *testing.T) {
+				m, err := decodeMessages([]byte(tc.data))
+
+				if tc.err {
+					assert.Error(t, err)
+				} else {
+					assert.NoError(t, err)
+					assert.Equal(t, tc.out, m)
+				}
+			},
+		)
+	}
+}
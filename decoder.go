@@ -0,0 +1,191 @@
+package i18n
+
+import (
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"golang.org/x/text/language"
+)
+
+// Decoder decodes translation messages of a file format. Register it for a
+// file extension with RegisterDecoder.
+//
+// A message with plural rules has Rules set, otherwise Message is used.
+type Decoder interface {
+	Decode(data []byte) ([]Message, error)
+}
+
+// DecoderFunc is an adapter to use a function as a Decoder.
+type DecoderFunc func(data []byte) ([]Message, error)
+
+// Decode translation messages.
+func (f DecoderFunc) Decode(data []byte) ([]Message, error) {
+	return f(data)
+}
+
+// Decoder of translation messages with the message structure validated as
+// is. It's implemented by built-in decoders.
+type messageDecoder interface {
+	decode(data []byte) ([]translationMessage, error)
+}
+
+// Decoder of files with translations of several languages, e.g. Xcode
+// String Catalogs. Such a file can be loaded as a bundle.
+type bundleDecoder interface {
+	decodeBundle(data []byte) (map[language.Tag][]translationMessage, error)
+}
+
+// Decoder of files with the language tag in the file name, e.g. Java
+// `messages_ru.properties`. Such files may be placed in the languages folder
+// root.
+type namedDecoder interface {
+	tagFromName(name string) (language.Tag, bool)
+}
+
+// Decoder of a file extension shared with other formats, e.g. Qt `.ts` and
+// TypeScript. Files of other formats are skipped while loading.
+type detectDecoder interface {
+	detect(data []byte) bool
+}
+
+// Check if the file has the language tag in its name, see namedDecoder.
+func isNamedFile(name string) bool {
+	d, ok := decoderFor(filepath.Ext(name))
+	if !ok {
+		return false
+	}
+
+	_, ok = d.(namedDecoder)
+
+	return ok
+}
+
+// Registered decoders by file extension.
+var decoders = struct {
+	sync.RWMutex
+	m map[string]Decoder
+}{
+	m: map[string]Decoder{
+		"":      jsonDecoder{},
+		".json": jsonDecoder{},
+		".yaml": yamlDecoder{},
+		".yml":  yamlDecoder{},
+		".arb":  arbDecoder{},
+		".xml":  androidDecoder{},
+
+		".strings":     stringsDecoder{},
+		".stringsdict": stringsdictDecoder{},
+		".xcstrings":   xcstringsDecoder{},
+
+		".po":         poDecoder{},
+		".properties": propertiesDecoder{},
+		".ts":         qtDecoder{},
+	},
+}
+
+// RegisterDecoder registers the decoder for files with the extension, e.g.
+// `.arb`. It replaces a decoder registered for the extension before. The
+// empty extension is used for files without an extension.
+func RegisterDecoder(ext string, d Decoder) {
+	decoders.Lock()
+	defer decoders.Unlock()
+
+	decoders.m[normalizeExt(ext)] = d
+}
+
+// Get a decoder for the file extension.
+func decoderFor(ext string) (Decoder, bool) {
+	decoders.RLock()
+	defer decoders.RUnlock()
+
+	d, ok := decoders.m[normalizeExt(ext)]
+
+	return d, ok
+}
+
+// Normalize the file extension: lower case with a leading dot.
+func normalizeExt(ext string) string {
+	ext = strings.ToLower(ext)
+
+	if ext != "" && !strings.HasPrefix(ext, ".") {
+		ext = "." + ext
+	}
+
+	return ext
+}
+
+// Decode messages with the decoder.
+func decode(d Decoder, data []byte) ([]translationMessage, error) {
+	if d, ok := d.(messageDecoder); ok {
+		return d.decode(data)
+	}
+
+	m, err := d.Decode(data)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]translationMessage, 0, len(m))
+	for _, message := range m {
+		result = append(result, newTranslationMessage(message))
+	}
+
+	return result, nil
+}
+
+// Decode messages of the language with the decoder.
+func decodeLanguage(d Decoder, tag language.Tag, data []byte) ([]translationMessage, error) {
+	if d, ok := d.(bundleDecoder); ok {
+		m, err := d.decodeBundle(data)
+
+		return m[tag], err
+	}
+
+	return decode(d, data)
+}
+
+// Decoder of JSON files.
+type jsonDecoder struct{}
+
+// Decode translation messages.
+func (d jsonDecoder) Decode(data []byte) ([]Message, error) {
+	return publicMessages(d.decode(data))
+}
+
+// Decode translation messages.
+func (jsonDecoder) decode(data []byte) ([]translationMessage, error) {
+	return decodeMessages(data)
+}
+
+// Decoder of YAML files.
+type yamlDecoder struct{}
+
+// Decode translation messages.
+func (d yamlDecoder) Decode(data []byte) ([]Message, error) {
+	return publicMessages(d.decode(data))
+}
+
+// Decode translation messages.
+func (yamlDecoder) decode(data []byte) ([]translationMessage, error) {
+	b, err := yamlToJSON(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeMessages(b)
+}
+
+// Convert translation messages to public messages.
+func publicMessages(m []translationMessage, err error) ([]Message, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Message, 0, len(m))
+	for _, message := range m {
+		result = append(result, newMessage(message))
+	}
+
+	return result, nil
+}
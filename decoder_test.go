@@ -0,0 +1,112 @@
+package i18n
+
+import (
+	"bufio"
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+)
+
+// Decode `id=message` lines.
+func decodeKeyValue(data []byte) ([]Message, error) {
+	var m []Message
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		if id, text, ok := strings.Cut(scanner.Text(), "="); ok {
+			m = append(m, Message{ID: id, Message: text})
+		}
+	}
+
+	return m, scanner.Err()
+}
+
+func Test_RegisterDecoder(t *testing.T) {
+	t.Parallel()
+
+	RegisterDecoder("KV", DecoderFunc(decodeKeyValue))
+
+	d, ok := decoderFor(".kv")
+	assert.True(t, ok)
+
+	dir := t.TempDir()
+	assert.NoError(t, os.MkdirAll(filepath.Join(dir, "en"), 0o755))
+	assert.NoError(t, os.WriteFile(
+		filepath.Join(dir, "en", "main.kv"),
+		[]byte("decoder hello=Hello\ndecoder bye=Bye\n"),
+		0o600,
+	))
+
+	i18n := New(&Config{Path: dir})
+	assert.NoError(t, i18n.Load())
+
+	assert.Equal(t, "Hello", i18n.Printer(language.English).Sprintf("decoder hello"))
+	assert.Equal(t, []string{"decoder bye", "decoder hello"}, i18n.IDs(language.English))
+
+	m, err := decode(d, []byte("=without id"))
+	assert.NoError(t, err)
+	assert.Error(t, (&translation{}).loadMessages(m))
+}
+
+func Test_BuiltinDecoders(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+		ext  string
+		data string
+		out  []Message
+		err  bool
+	}{
+		{
+			name: "json",
+			ext:  ".json",
+			data: `[{"id": "hello", "message": "Hello", "description": "Greeting"}]`,
+			out:  []Message{{ID: "hello", Message: "Hello", Description: "Greeting"}},
+		},
+		{
+			name: "file without extension",
+			ext:  "",
+			data: `{"hello": "Hello"}`,
+			out:  []Message{{ID: "hello", Message: "Hello"}},
+		},
+		{
+			name: "yaml",
+			ext:  ".YML",
+			data: "apples:\n  one: '%d apple'\n  other: '%d apples'\n",
+			out: []Message{
+				{ID: "apples", Rules: map[string]interface{}{"one": "%d apple", "other": "%d apples"}},
+			},
+		},
+		{
+			name: "wrong json",
+			ext:  ".json",
+			data: `[`,
+			err:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name,
+			func(t *testing.T) {
+				d, ok := decoderFor(tc.ext)
+				assert.True(t, ok)
+
+				m, err := d.Decode([]byte(tc.data))
+
+				if tc.err {
+					assert.Error(t, err)
+				} else {
+					assert.NoError(t, err)
+					assert.Equal(t, tc.out, m)
+				}
+			},
+		)
+	}
+}
@@ -0,0 +1,49 @@
+package i18n
+
+import (
+	"golang.org/x/text/language"
+)
+
+// Direction of a text.
+type Direction int
+
+// Text directions.
+const (
+	// LTR is a left-to-right text.
+	LTR Direction = iota
+	// RTL is a right-to-left text.
+	RTL
+)
+
+// String returns `ltr` or `rtl`, values of the HTML dir attribute.
+func (d Direction) String() string {
+	if d == RTL {
+		return "rtl"
+	}
+
+	return "ltr"
+}
+
+// Right-to-left scripts.
+var rtlScripts = map[string]bool{
+	"Adlm": true,
+	"Arab": true,
+	"Hebr": true,
+	"Mand": true,
+	"Nkoo": true,
+	"Rohg": true,
+	"Samr": true,
+	"Syrc": true,
+	"Thaa": true,
+}
+
+// Get the text direction of the language.
+func direction(tag language.Tag) Direction {
+	script, _ := tag.Script()
+
+	if rtlScripts[script.String()] {
+		return RTL
+	}
+
+	return LTR
+}
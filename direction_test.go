@@ -0,0 +1,37 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+)
+
+func Test_Direction(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		tag       string
+		direction Direction
+	}{
+		{tag: "en", direction: LTR},
+		{tag: "ru", direction: LTR},
+		{tag: "ar", direction: RTL},
+		{tag: "he", direction: RTL},
+		{tag: "fa", direction: RTL},
+		{tag: "az-Arab", direction: RTL},
+		{tag: "und", direction: LTR},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.tag,
+			func(t *testing.T) {
+				assert.Equal(t, tc.direction, direction(language.MustParse(tc.tag)))
+			},
+		)
+	}
+
+	assert.Equal(t, "ltr", LTR.String())
+	assert.Equal(t, "rtl", RTL.String())
+}
@@ -0,0 +1,84 @@
+package i18n
+
+import (
+	"golang.org/x/text/currency"
+	"golang.org/x/text/language"
+	"golang.org/x/text/language/display"
+)
+
+// Prefixes of reserved message ids overriding display names, e.g.
+// `language:pt-BR`, `region:DE` or `currency:EUR`.
+const (
+	languageNamePrefix = "language:"
+	regionNamePrefix   = "region:"
+	currencyNamePrefix = "currency:"
+)
+
+// DisplayName returns the name of the language in the language in. A message
+// `language:<tag>` of the best loaded language for in overrides the name. If
+// names in the language in are not available, the name of the language in
+// itself is returned, the tag if it is unknown too.
+func (i *I18n) DisplayName(tag, in language.Tag) string {
+	if name, ok := i.displayOverride(in, languageNamePrefix+tag.String()); ok {
+		return name
+	}
+
+	// A namer is nil for languages without display names.
+	if namer := display.Tags(in); namer != nil {
+		if name := namer.Name(tag); name != "" {
+			return name
+		}
+	}
+
+	if name := display.Self.Name(tag); name != "" {
+		return name
+	}
+
+	return tag.String()
+}
+
+// RegionName returns the name of the region in the language in. A message
+// `region:<code>` of the best loaded language for in overrides the name. The
+// region code is returned if names in the language in are not available.
+func (i *I18n) RegionName(region language.Region, in language.Tag) string {
+	if name, ok := i.displayOverride(in, regionNamePrefix+region.String()); ok {
+		return name
+	}
+
+	// A namer is nil for languages without display names.
+	if namer := display.Regions(in); namer != nil {
+		if name := namer.Name(region); name != "" {
+			return name
+		}
+	}
+
+	return region.String()
+}
+
+// CurrencyName returns the name of the currency in the language in from a
+// message `currency:<code>` of the best loaded language for in. There is no
+// built-in data of currency names, so without the message the ISO 4217 code
+// is returned in every language.
+func (i *I18n) CurrencyName(unit currency.Unit, in language.Tag) string {
+	if name, ok := i.displayOverride(in, currencyNamePrefix+unit.String()); ok {
+		return name
+	}
+
+	return unit.String()
+}
+
+// Get the text of a reserved message of the best loaded language for the
+// tag.
+func (i *I18n) displayOverride(tag language.Tag, id string) (string, bool) {
+	tag, ok := i.Match(tag)
+	if !ok {
+		return "", false
+	}
+
+	m, ok := i.index.lookup(tag, id)
+	if !ok || m.Message == nil {
+		return "", false
+	}
+
+	return *m.Message, true
+}
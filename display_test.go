@@ -0,0 +1,123 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/currency"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+func Test_I18nDisplayNames(t *testing.T) {
+	t.Parallel()
+
+	i18n := New(&Config{Fallback: language.English})
+
+	for _, tag := range []language.Tag{language.English, language.Russian} {
+		i18n.printer[tag] = message.NewPrinter(tag)
+	}
+
+	tr := &translation{tag: language.Russian, index: i18n.index}
+	assert.NoError(t, tr.append([]byte(`[
+    {"id": "language:pt-BR", "message": "бразильский португальский"},
+    {"id": "region:CZ", "message": "Чехия"},
+    {"id": "currency:EUR", "message": "евро"}
+  ]`)))
+
+	testCases := []struct {
+		name string
+		fn   func() string
+		out  string
+	}{
+		{
+			name: "language",
+			fn:   func() string { return i18n.DisplayName(language.German, language.Russian) },
+			out:  "немецкий",
+		},
+		{
+			name: "language override",
+			fn:   func() string { return i18n.DisplayName(language.BrazilianPortuguese, language.Russian) },
+			out:  "бразильский португальский",
+		},
+		{
+			name: "language of regional language",
+			fn:   func() string { return i18n.DisplayName(language.BrazilianPortuguese, language.MustParse("ru-UA")) },
+			out:  "бразильский португальский",
+		},
+		{
+			name: "language not loaded",
+			fn:   func() string { return i18n.DisplayName(language.German, language.French) },
+			out:  "allemand",
+		},
+		{
+			name: "region",
+			fn:   func() string { return i18n.RegionName(language.MustParseRegion("DE"), language.English) },
+			out:  "Germany",
+		},
+		{
+			name: "region override",
+			fn:   func() string { return i18n.RegionName(language.MustParseRegion("CZ"), language.Russian) },
+			out:  "Чехия",
+		},
+		{
+			name: "currency override",
+			fn:   func() string { return i18n.CurrencyName(currency.EUR, language.Russian) },
+			out:  "евро",
+		},
+		{
+			name: "currency code",
+			fn:   func() string { return i18n.CurrencyName(currency.EUR, language.English) },
+			out:  "EUR",
+		},
+		{
+			name: "currency code of not loaded language",
+			fn:   func() string { return i18n.CurrencyName(currency.JPY, language.Japanese) },
+			out:  "JPY",
+		},
+		{
+			name: "currency code without override",
+			fn:   func() string { return i18n.CurrencyName(currency.USD, language.Russian) },
+			out:  "USD",
+		},
+		{
+			name: "language of undefined language",
+			fn:   func() string { return i18n.DisplayName(language.German, language.Und) },
+			out:  "Deutsch",
+		},
+		{
+			name: "language of private use language",
+			fn:   func() string { return i18n.DisplayName(language.German, language.MustParse("qaa")) },
+			out:  "Deutsch",
+		},
+		{
+			name: "language without names",
+			fn:   func() string { return i18n.DisplayName(language.German, language.MustParse("ain")) },
+			out:  "Deutsch",
+		},
+		{
+			name: "unknown language without names",
+			fn:   func() string { return i18n.DisplayName(language.MustParse("qaa"), language.MustParse("ain")) },
+			out:  "qaa",
+		},
+		{
+			name: "region of undefined language",
+			fn:   func() string { return i18n.RegionName(language.MustParseRegion("DE"), language.Und) },
+			out:  "DE",
+		},
+		{
+			name: "region of language without names",
+			fn:   func() string { return i18n.RegionName(language.MustParseRegion("DE"), language.MustParse("ain")) },
+			out:  "DE",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name,
+			func(t *testing.T) {
+				assert.Equal(t, tc.out, tc.fn())
+			},
+		)
+	}
+}
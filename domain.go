@@ -0,0 +1,209 @@
+package i18n
+
+import (
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/message/catalog"
+)
+
+// Domain is a set of messages of a module, e.g. `billing`, with its own
+// catalog and index, so message ids of different domains don't collide with
+// each other and with messages without a domain.
+type Domain struct {
+	name string
+	i18n *I18n
+}
+
+// Messages of a translation domain.
+type domain struct {
+	catalog *catalog.Builder
+	printer map[language.Tag]*message.Printer
+	index   *index
+	// Messages of translation files.
+	files *index
+}
+
+// Domain returns the translation domain. Domains of translation files are
+// set with Config.Domain, e.g. DomainFromPath. Messages of a domain are
+// available only with the domain, I18n.Printer, I18n.Has, I18n.IDs,
+// I18n.Message and I18n.Export don't see them.
+func (i *I18n) Domain(name string) *Domain {
+	return &Domain{name: name, i18n: i}
+}
+
+// Domains returns sorted names of loaded domains.
+func (i *I18n) Domains() []string {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	result := make([]string, 0, len(i.domains))
+	for name := range i.domains {
+		result = append(result, name)
+	}
+
+	sort.Strings(result)
+
+	return result
+}
+
+// Printer returns a printer of the language which resolves message ids only
+// within the domain. The fallback language is used if the language isn't
+// loaded.
+func (d *Domain) Printer(tag language.Tag) *message.Printer {
+	i := d.i18n
+
+	i.mu.RLock()
+	_, loaded := i.printer[tag]
+	dom, ok := i.domains[d.name]
+
+	if ok {
+		if printer, ok := dom.printer[tag]; ok {
+			i.mu.RUnlock()
+			return printer
+		}
+	}
+
+	i.mu.RUnlock()
+
+	if !loaded {
+		tag = i.config.Fallback
+	}
+
+	if !ok {
+		return message.NewPrinter(tag, message.Catalog(catalog.NewBuilder()))
+	}
+
+	printer := message.NewPrinter(tag, message.Catalog(dom.catalog))
+
+	i.mu.Lock()
+	dom.printer[tag] = printer
+	i.mu.Unlock()
+
+	return printer
+}
+
+// Has reports whether the message is loaded for the language in the domain.
+func (d *Domain) Has(tag language.Tag, id string) bool {
+	_, ok := d.index().lookup(tag, id)
+
+	return ok
+}
+
+// IDs returns sorted message ids of the domain loaded for the language.
+func (d *Domain) IDs(tag language.Tag) []string {
+	return d.index().ids(tag)
+}
+
+// Message returns a loaded message of the language in the domain.
+func (d *Domain) Message(tag language.Tag, id string) (Message, bool) {
+	m, ok := d.index().lookup(tag, id)
+	if !ok {
+		return Message{}, false
+	}
+
+	result := newMessage(m)
+	result.Domain = d.name
+
+	return result, true
+}
+
+// Export messages and plural rules of the domain for a loaded language.
+// Messages are sorted by id.
+func (d *Domain) Export(w io.Writer, tag language.Tag, format Format) error {
+	return d.i18n.export(w, tag, format, d.index())
+}
+
+// Get the index of the domain, nil if the domain isn't loaded.
+func (d *Domain) index() *index {
+	d.i18n.mu.RLock()
+	defer d.i18n.mu.RUnlock()
+
+	if dom, ok := d.i18n.domains[d.name]; ok {
+		return dom.index
+	}
+
+	return nil
+}
+
+// Get the domain of the translation file, nil if the file has no domain.
+func (i *I18n) domainFor(path string) *domain {
+	name := i.domainOf(i.relative(path))
+	if name == "" {
+		return nil
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	return i.domain(name)
+}
+
+// Get the domain by name, a new domain is created. The lock must be held.
+func (i *I18n) domain(name string) *domain {
+	if i.domains == nil {
+		i.domains = make(map[string]*domain)
+	}
+
+	dom, ok := i.domains[name]
+	if !ok {
+		dom = &domain{
+			catalog: catalog.NewBuilder(),
+			printer: make(map[language.Tag]*message.Printer),
+			index:   newIndex(),
+		}
+		i.domains[name] = dom
+	}
+
+	return dom
+}
+
+// DomainFromPath gets a translation domain from a file path of the default
+// layout: the first path element below the language folder without
+// extension, e.g. `billing` of `ru/billing.json` or `ru/billing/errors.json`.
+// A file with the tag in the name gets the name before the tag, e.g.
+// `messages` of `messages_ru.properties`. A language file, e.g. `ru.json`,
+// has no domain.
+func DomainFromPath(path string) string {
+	parts := strings.Split(path, "/")
+	if len(parts) < 2 {
+		if !isNamedFile(parts[0]) {
+			return ""
+		}
+
+		name, _, _ := splitSuffixTag(parts[0])
+
+		return name
+	}
+
+	if len(parts) == 2 {
+		return strings.TrimSuffix(parts[1], filepath.Ext(parts[1]))
+	}
+
+	return parts[1]
+}
+
+// Get a domain of the translation file with Config.Domain, files have no
+// domain by default.
+func (i *I18n) domainOf(path string) string {
+	if i.config.Domain == nil {
+		return ""
+	}
+
+	return i.config.Domain(path)
+}
+
+// Create a translation of the language file. Messages of a domain file are
+// loaded into the domain catalog and index only.
+func (i *I18n) newTranslation(tag language.Tag, path string) *translation {
+	tr := &translation{tag: tag, filePath: path, index: i.index, domain: i.domainFor(path)}
+	if tr.domain != nil {
+		tr.index = tr.domain.index
+	}
+
+	return tr
+}
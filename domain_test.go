@@ -0,0 +1,115 @@
+package i18n
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+)
+
+func Test_I18nDomain(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	files := map[string]string{
+		"en/auth.json":           `{"domain title": "Sign in", "domain apples": {"one": "%d apple", "other": "%d apples"}}`,
+		"en/billing/titles.json": `{"domain title": "Invoice"}`,
+		"ru/auth.json":           `{"domain title": "Вход"}`,
+		"ru/billing/titles.json": `{"domain title": "Счёт"}`,
+	}
+
+	for name, data := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+
+		assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		assert.NoError(t, os.WriteFile(path, []byte(data), 0o600))
+	}
+
+	i18n := New(&Config{Path: dir, Fallback: language.English, Domain: DomainFromPath})
+	assert.NoError(t, i18n.Load())
+
+	assert.Equal(t, []string{"auth", "billing"}, i18n.Domains())
+
+	assert.Empty(t, i18n.IDs(language.English))
+	assert.False(t, i18n.Has(language.English, "domain title"))
+	assert.Equal(t, "domain title", i18n.Printer(language.English).Sprintf("domain title"))
+
+	assert.Equal(t, []string{"domain apples", "domain title"}, i18n.Domain("auth").IDs(language.English))
+	assert.Equal(t, []string{"domain title"}, i18n.Domain("billing").IDs(language.English))
+	assert.True(t, i18n.Domain("billing").Has(language.Russian, "domain title"))
+	assert.False(t, i18n.Domain("billing").Has(language.English, "domain apples"))
+	assert.Empty(t, i18n.Domain("unknown").IDs(language.English))
+
+	m, ok := i18n.Domain("billing").Message(language.Russian, "domain title")
+	assert.True(t, ok)
+	assert.Equal(t, "Счёт", m.Message)
+
+	var b strings.Builder
+	assert.NoError(t, i18n.Domain("billing").Export(&b, language.English, FormatFlatJSON))
+	assert.JSONEq(t, `{"domain title": "Invoice"}`, b.String())
+
+	testCases := []struct {
+		domain string
+		tag    language.Tag
+		out    string
+	}{
+		{domain: "auth", tag: language.English, out: "Sign in"},
+		{domain: "auth", tag: language.Russian, out: "Вход"},
+		{domain: "billing", tag: language.English, out: "Invoice"},
+		{domain: "billing", tag: language.Russian, out: "Счёт"},
+		{domain: "billing", tag: language.German, out: "Invoice"},
+		{domain: "unknown", tag: language.English, out: "domain title"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.domain+" "+tc.tag.String(),
+			func(t *testing.T) {
+				assert.Equal(t, tc.out, i18n.Domain(tc.domain).Printer(tc.tag).Sprintf("domain title"))
+			},
+		)
+	}
+
+	p := i18n.Domain("auth").Printer(language.English)
+	assert.Equal(t, "5 apples", p.Sprintf("domain apples", 5))
+	assert.Equal(t, "domain apples", i18n.Domain("billing").Printer(language.English).Sprintf("domain apples"))
+}
+
+func Test_I18nDomainOf(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name   string
+		config *Config
+		path   string
+		out    string
+	}{
+		{name: "default", config: &Config{}, path: "ru/billing.json"},
+		{name: "file", config: &Config{Domain: DomainFromPath}, path: "ru/billing.json", out: "billing"},
+		{name: "folder", config: &Config{Domain: DomainFromPath}, path: "ru/billing/errors/main.json", out: "billing"},
+		{name: "language file", config: &Config{Domain: DomainFromPath}, path: "ru.json"},
+		{name: "named file", config: &Config{Domain: DomainFromPath}, path: "errors_ru.properties", out: "errors"},
+		{
+			name: "custom",
+			config: &Config{
+				Layout: LayoutName,
+				Domain: func(path string) string { return strings.Split(path, ".")[0] },
+			},
+			path: "billing.ru.json",
+			out:  "billing",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name,
+			func(t *testing.T) {
+				assert.Equal(t, tc.out, New(tc.config).domainOf(tc.path))
+			},
+		)
+	}
+}
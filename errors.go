@@ -45,3 +45,63 @@ func (i *ErrorMessageValidate) Error() string {
 		i.Message,
 	)
 }
+
+// ErrorPattern reports a malformed include or exclude pattern.
+type ErrorPattern struct {
+	Pattern string
+	Err     error
+}
+
+// Error message.
+func (i *ErrorPattern) Error() string {
+	return fmt.Sprintf("wrong pattern `%v`: %v", i.Pattern, i.Err)
+}
+
+// Unwrap returns the underlying error.
+func (i *ErrorPattern) Unwrap() error {
+	return i.Err
+}
+
+// ErrorLanguageTagNotExists reports that the language is not loaded.
+type ErrorLanguageTagNotExists struct {
+	Tag language.Tag
+}
+
+// Error message.
+func (i *ErrorLanguageTagNotExists) Error() string {
+	return fmt.Sprintf("language tag `%v` doesn't exists", i.Tag)
+}
+
+// ErrorFormatNotSupported reports an unknown export format or a translation
+// file extension without a registered decoder.
+type ErrorFormatNotSupported struct {
+	Format Format
+}
+
+// Error message.
+func (i *ErrorFormatNotSupported) Error() string {
+	return fmt.Sprintf("format `%v` is not supported", i.Format)
+}
+
+// ErrorDecode reports a malformed or unsupported translation file content.
+type ErrorDecode struct {
+	Format  string
+	Message string
+}
+
+// Error message.
+func (i *ErrorDecode) Error() string {
+	return fmt.Sprintf("%v decode error: %v", i.Format, i.Message)
+}
+
+// ErrorEncode reports a message which can't be exported to the format.
+type ErrorEncode struct {
+	Format    Format
+	MessageID string
+	Message   string
+}
+
+// Error message.
+func (i *ErrorEncode) Error() string {
+	return fmt.Sprintf("%v encode error (id: %v): %v", i.Format, i.MessageID, i.Message)
+}
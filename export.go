@@ -0,0 +1,224 @@
+package i18n
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/language"
+	"gopkg.in/yaml.v3"
+)
+
+// Format of exported translations.
+type Format string
+
+// Supported export formats.
+const (
+	// FormatJSON is an array of message objects.
+	FormatJSON Format = "json"
+	// FormatYAML is an array of message objects.
+	FormatYAML Format = "yaml"
+	// FormatFlatJSON is an object with message ids as keys.
+	FormatFlatJSON Format = "flat-json"
+	// FormatPO is a gettext PO file.
+	FormatPO Format = "po"
+	// FormatXLIFF is an XLIFF 2.0 file.
+	FormatXLIFF Format = "xliff"
+)
+
+// Encode messages of a language.
+type encoder func(w io.Writer, tag language.Tag, m []translationMessage) error
+
+// Encoders of export formats.
+var encoders = map[Format]encoder{
+	FormatJSON:     encodeJSON,
+	FormatYAML:     encodeYAML,
+	FormatFlatJSON: encodeFlatJSON,
+	FormatPO:       encodePO,
+	FormatXLIFF:    encodeXLIFF,
+	FormatARB:      encodeARB,
+	FormatAndroid:  encodeAndroid,
+
+	FormatStrings:     encodeStrings,
+	FormatStringsdict: encodeStringsdict,
+	FormatXCStrings:   encodeXCStrings,
+}
+
+// Export messages and plural rules of a loaded language. Messages are sorted
+// by id.
+func (i *I18n) Export(w io.Writer, tag language.Tag, format Format) error {
+	return i.export(w, tag, format, i.index)
+}
+
+// Export messages of the index.
+func (i *I18n) export(w io.Writer, tag language.Tag, format Format, index *index) error {
+	encode, ok := encoders[format]
+	if !ok {
+		return &ErrorFormatNotSupported{Format: format}
+	}
+
+	i.mu.RLock()
+	_, ok = i.printer[tag]
+	i.mu.RUnlock()
+
+	if !ok {
+		return &ErrorLanguageTagNotExists{Tag: tag}
+	}
+
+	return encode(w, tag, index.list(tag))
+}
+
+// Encode messages as an array of message objects.
+func encodeJSON(w io.Writer, _ language.Tag, m []translationMessage) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(m)
+}
+
+// Encode messages as YAML array of message objects.
+func encodeYAML(w io.Writer, _ language.Tag, m []translationMessage) error {
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+
+	if err := encoder.Encode(m); err != nil {
+		return err
+	}
+
+	return encoder.Close()
+}
+
+// Encode messages as an object with message ids as keys.
+func encodeFlatJSON(w io.Writer, _ language.Tag, m []translationMessage) error {
+	result := make(map[string]interface{}, len(m))
+
+	for _, message := range m {
+		if message.Message != nil {
+			result[message.ID] = *message.Message
+		} else {
+			result[message.ID] = message.Rules
+		}
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(result)
+}
+
+// Plural rules of a substitution argument.
+type pluralArgument struct {
+	number    int
+	selectors []string
+	messages  map[string]string
+}
+
+// Get plural rules grouped by substitution argument.
+func pluralArguments(rules interface{}) []pluralArgument {
+	v, ok := rules.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	var result []pluralArgument
+
+	first := pluralArgument{number: 1, messages: make(map[string]string)}
+
+	for key, value := range v {
+		switch value := value.(type) {
+		case string:
+			first.messages[key] = value
+		case map[string]interface{}:
+			number, _ := strconv.Atoi(key)
+			arg := pluralArgument{number: number, messages: make(map[string]string)}
+
+			for selector, message := range value {
+				arg.messages[selector] = fmt.Sprint(message)
+			}
+
+			result = append(result, arg)
+		}
+	}
+
+	if len(first.messages) > 0 {
+		result = append(result, first)
+	}
+
+	for n := range result {
+		for selector := range result[n].messages {
+			result[n].selectors = append(result[n].selectors, selector)
+		}
+
+		sortSelectors(result[n].selectors)
+	}
+
+	sort.Slice(result, func(a, b int) bool { return result[a].number < result[b].number })
+
+	return result
+}
+
+// Check the argument for comparison selectors `<N` and `>N`, which most
+// formats don't support. An error message is returned for the first one.
+func comparisonError(arg pluralArgument) (string, bool) {
+	for _, selector := range arg.selectors {
+		if strings.HasPrefix(selector, "<") || strings.HasPrefix(selector, ">") {
+			return fmt.Sprintf("plural selector `%v` is not supported", selector), true
+		}
+	}
+
+	return "", false
+}
+
+// Sort plural selectors: exact values `=N` first, then comparisons `<N`,
+// then plural categories in CLDR order with `other` last. Cases are matched
+// in order, so an exact value must go before a comparison that includes it.
+func sortSelectors(s []string) {
+	sort.SliceStable(s, func(a, b int) bool {
+		if selectorOrder(s[a]) != selectorOrder(s[b]) {
+			return selectorOrder(s[a]) < selectorOrder(s[b])
+		}
+
+		if selectorOrder(s[a]) <= 1 {
+			na, errA := strconv.Atoi(s[a][1:])
+			nb, errB := strconv.Atoi(s[b][1:])
+
+			if errA == nil && errB == nil && na != nb {
+				return na < nb
+			}
+		}
+
+		return s[a] < s[b]
+	})
+}
+
+// Get the order of a plural selector.
+func selectorOrder(selector string) int {
+	switch selector {
+	case "zero":
+		return 2
+	case "one":
+		return 3
+	case "two":
+		return 4
+	case "few":
+		return 5
+	case "many":
+		return 6
+	case "other":
+		return 7
+	}
+
+	switch {
+	case strings.HasPrefix(selector, "="):
+		return 0
+	case strings.HasPrefix(selector, "<"), strings.HasPrefix(selector, ">"):
+		return 1
+	}
+
+	return 6
+}
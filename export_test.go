@@ -0,0 +1,141 @@
+package i18n
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+func Test_I18nExport(t *testing.T) {
+	t.Parallel()
+
+	i18n := New(&Config{})
+	i18n.printer[language.Russian] = message.NewPrinter(language.Russian)
+
+	tr := &translation{tag: language.Russian, index: i18n.index}
+	assert.NoError(t, tr.append([]byte(`[
+    {"id": "export \"quoted\"", "message": "строка\nвторая"},
+    {"id": "export apple", "rules": {"other": "%d яблок", "=0": "нет яблок", "one": "%d яблоко"}}
+  ]`)))
+
+	testCases := []struct {
+		name   string
+		tag    language.Tag
+		format Format
+		out    string
+		err    bool
+	}{
+		{
+			name:   "json",
+			tag:    language.Russian,
+			format: FormatJSON,
+			out: `[
+  {
+    "id": "export \"quoted\"",
+    "message": "строка\nвторая"
+  },
+  {
+    "id": "export apple",
+    "rules": {
+      "=0": "нет яблок",
+      "one": "%d яблоко",
+      "other": "%d яблок"
+    }
+  }
+]
+`,
+		},
+		{
+			name:   "yaml",
+			tag:    language.Russian,
+			format: FormatYAML,
+			out: `- id: export "quoted"
+  message: |-
+    строка
+    вторая
+- id: export apple
+  rules:
+    =0: нет яблок
+    one: '%d яблоко'
+    other: '%d яблок'
+`,
+		},
+		{
+			name:   "flat json",
+			tag:    language.Russian,
+			format: FormatFlatJSON,
+			out: `{
+  "export \"quoted\"": "строка\nвторая",
+  "export apple": {
+    "=0": "нет яблок",
+    "one": "%d яблоко",
+    "other": "%d яблок"
+  }
+}
+`,
+		},
+		{
+			name:   "po",
+			tag:    language.Russian,
+			format: FormatPO,
+			out: `msgid ""
+msgstr ""
+"Language: ru\n"
+"Content-Type: text/plain; charset=UTF-8\n"
+"Plural-Forms: nplurals=3; plural=(n % 10 == 1 && n % 100 != 11 ? 0 : n % 10 >= 2 && n % 10 <= 4 && (n % 100 < 12 || n % 100 > 14) ? 1 : 2);\n"
+
+msgid "export \"quoted\""
+msgstr "строка\nвторая"
+
+#. plural =0: "нет яблок"
+msgid "export apple"
+msgid_plural "export apple"
+msgstr[0] "%d яблоко"
+msgstr[1] "%d яблок"
+msgstr[2] "%d яблок"
+`,
+		},
+		{
+			name:   "language doesn't exists",
+			tag:    language.German,
+			format: FormatJSON,
+			err:    true,
+		},
+		{
+			name:   "format is not supported",
+			tag:    language.Russian,
+			format: Format("xml"),
+			err:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name,
+			func(t *testing.T) {
+				var out bytes.Buffer
+
+				err := i18n.Export(&out, tc.tag, tc.format)
+
+				if tc.err {
+					assert.Error(t, err)
+				} else {
+					assert.NoError(t, err)
+					assert.Equal(t, tc.out, out.String())
+				}
+			},
+		)
+	}
+}
+
+func Test_SortSelectors(t *testing.T) {
+	t.Parallel()
+
+	s := []string{"other", "<5", "one", "=10", "many", "=0", "<2", "few"}
+	sortSelectors(s)
+
+	assert.Equal(t, []string{"=0", "=10", "<2", "<5", "one", "few", "many", "other"}, s)
+}
@@ -0,0 +1,49 @@
+package i18n
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// Get a path of Config.FS: slash separated and cleaned, the root for the
+// empty path.
+func fsPath(name string) string {
+	return filepath.ToSlash(filepath.Clean(name))
+}
+
+// Get information about a file of Config.FS or the OS file system.
+func (i *I18n) stat(name string) (fs.FileInfo, error) {
+	if i.config.FS != nil {
+		return fs.Stat(i.config.FS, fsPath(name))
+	}
+
+	return os.Stat(name)
+}
+
+// Read a directory of Config.FS or the OS file system.
+func (i *I18n) readDir(name string) ([]fs.DirEntry, error) {
+	if i.config.FS != nil {
+		return fs.ReadDir(i.config.FS, fsPath(name))
+	}
+
+	return os.ReadDir(name)
+}
+
+// Read a file of Config.FS or the OS file system.
+func (i *I18n) readFile(name string) ([]byte, error) {
+	if i.config.FS != nil {
+		return fs.ReadFile(i.config.FS, fsPath(name))
+	}
+
+	return os.ReadFile(name)
+}
+
+// Walk a directory tree of Config.FS or the OS file system.
+func (i *I18n) walkDir(root string, fn fs.WalkDirFunc) error {
+	if i.config.FS != nil {
+		return fs.WalkDir(i.config.FS, fsPath(root), fn)
+	}
+
+	return filepath.WalkDir(root, fn)
+}
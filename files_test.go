@@ -0,0 +1,89 @@
+package i18n
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+)
+
+func Test_I18nLoadFS(t *testing.T) {
+	t.Parallel()
+
+	fsys := fstest.MapFS{
+		"i18n/en/main.json":        {Data: []byte(`[{"id": "fs hello", "message": "Hello"}]`)},
+		"i18n/ru.yaml":             {Data: []byte(`[{id: fs hello, message: Привет}]`)},
+		"i18n/de/.hidden.json":     {Data: []byte(`broken`)},
+		"layout/app.en.json":       {Data: []byte(`[{"id": "fs layout", "message": "Layout"}]`)},
+		"bundle.json":              {Data: []byte(`{"fs bundle": {"en": "Bundle", "ru": "Бандл"}}`)},
+		"i18n/de/messages.json":    {Data: []byte(`[{"id": "fs hello", "message": "Hallo"}]`)},
+		"missing/en/messages.json": {Data: []byte(`broken`)},
+	}
+
+	testCases := []struct {
+		name   string
+		config *Config
+		tag    language.Tag
+		id     string
+		out    string
+		err    bool
+	}{
+		{
+			name:   "folder",
+			config: &Config{FS: fsys, Path: "i18n"},
+			tag:    language.Russian,
+			id:     "fs hello",
+			out:    "Привет",
+		},
+		{
+			name:   "dot path",
+			config: &Config{FS: fsys, Path: "./i18n/"},
+			tag:    language.German,
+			id:     "fs hello",
+			out:    "Hallo",
+		},
+		{
+			name:   "layout",
+			config: &Config{FS: fsys, Path: "layout", Layout: LayoutName},
+			tag:    language.English,
+			id:     "fs layout",
+			out:    "Layout",
+		},
+		{
+			name:   "bundle",
+			config: &Config{FS: fsys, Path: "bundle.json"},
+			tag:    language.Russian,
+			id:     "fs bundle",
+			out:    "Бандл",
+		},
+		{
+			name:   "path doesn't exist",
+			config: &Config{FS: fsys, Path: "unknown"},
+			err:    true,
+		},
+		{
+			name:   "broken file",
+			config: &Config{FS: fsys, Path: "missing"},
+			err:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name,
+			func(t *testing.T) {
+				i18n := New(tc.config)
+				err := i18n.Load()
+
+				if tc.err {
+					assert.Error(t, err)
+				} else {
+					assert.NoError(t, err)
+					assert.True(t, i18n.Has(tc.tag, tc.id))
+					assert.Equal(t, tc.out, i18n.Printer(tc.tag).Sprintf(tc.id))
+				}
+			},
+		)
+	}
+}
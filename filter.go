@@ -0,0 +1,102 @@
+package i18n
+
+import (
+	"io/fs"
+	"path/filepath"
+	"strings"
+)
+
+// Validate include and exclude patterns.
+func (i *I18n) validatePatterns() error {
+	for _, patterns := range [][]string{i.config.Include, i.config.Exclude} {
+		for _, pattern := range patterns {
+			if _, err := filepath.Match(pattern, ""); err != nil {
+				return &ErrorPattern{Pattern: pattern, Err: err}
+			}
+		}
+	}
+
+	return nil
+}
+
+// Check if the entry should be skipped while loading translations.
+func (i *I18n) skip(path string, entry fs.DirEntry) bool {
+	name := entry.Name()
+
+	if strings.HasPrefix(name, ".") {
+		i.debugf("skip hidden %v", path)
+		return true
+	}
+
+	if matchAny(i.config.Exclude, i.relative(path)) {
+		i.debugf("skip excluded %v", path)
+		return true
+	}
+
+	if entry.IsDir() {
+		return false
+	}
+
+	d, ok := decoderFor(filepath.Ext(name))
+	if !ok {
+		i.debugf("skip unknown extension %v", path)
+		return true
+	}
+
+	if len(i.config.Include) > 0 && !matchAny(i.config.Include, i.relative(path)) {
+		i.debugf("skip not included %v", path)
+		return true
+	}
+
+	if d, ok := d.(detectDecoder); ok && !i.detectFile(d, path) {
+		i.debugf("skip unknown format %v", path)
+		return true
+	}
+
+	return false
+}
+
+// Check if the file has the format of the decoder. A file which can't be
+// read isn't skipped, the error is returned while loading.
+func (i *I18n) detectFile(d detectDecoder, path string) bool {
+	b, err := i.readFile(path)
+	if err != nil {
+		return true
+	}
+
+	return d.detect(b)
+}
+
+// Get a slash separated path relative to the languages folder.
+func (i *I18n) relative(path string) string {
+	rel, err := filepath.Rel(i.config.Path, path)
+	if err != nil {
+		return filepath.ToSlash(path)
+	}
+
+	return filepath.ToSlash(rel)
+}
+
+// Report a debug message.
+func (i *I18n) debugf(format string, v ...interface{}) {
+	if i.config.Debug != nil {
+		i.config.Debug(format, v...)
+	}
+}
+
+// Check if the relative path matches any of patterns. A pattern without a
+// separator is matched against the base name only.
+func matchAny(patterns []string, rel string) bool {
+	for _, pattern := range patterns {
+		name := rel
+		if !strings.Contains(pattern, "/") {
+			name = filepath.Base(filepath.FromSlash(rel))
+		}
+
+		if ok, _ := filepath.Match(filepath.FromSlash(pattern), filepath.FromSlash(name)); ok {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,131 @@
+package i18n
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_I18nSkip(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name   string
+		config *Config
+		path   string
+		entry  fakeDirEntry
+		skip   bool
+	}{
+		{
+			name:   "translation file",
+			config: &Config{Path: "i18n"},
+			path:   "i18n/en/main.json",
+			entry:  fakeDirEntry{name: "main.json"},
+		},
+		{
+			name:   "file without extension",
+			config: &Config{Path: "i18n"},
+			path:   "i18n/en",
+			entry:  fakeDirEntry{name: "en"},
+		},
+		{
+			name:   "hidden file",
+			config: &Config{Path: "i18n"},
+			path:   "i18n/en/.gitkeep",
+			entry:  fakeDirEntry{name: ".gitkeep"},
+			skip:   true,
+		},
+		{
+			name:   "hidden directory",
+			config: &Config{Path: "i18n"},
+			path:   "i18n/.git",
+			entry:  fakeDirEntry{name: ".git", isDir: true},
+			skip:   true,
+		},
+		{
+			name:   "unknown extension",
+			config: &Config{Path: "i18n"},
+			path:   "i18n/README.md",
+			entry:  fakeDirEntry{name: "README.md"},
+			skip:   true,
+		},
+		{
+			name:   "excluded by name",
+			config: &Config{Path: "i18n", Exclude: []string{"draft*"}},
+			path:   "i18n/en/draft.json",
+			entry:  fakeDirEntry{name: "draft.json"},
+			skip:   true,
+		},
+		{
+			name:   "excluded by path",
+			config: &Config{Path: "i18n", Exclude: []string{"en/old"}},
+			path:   "i18n/en/old",
+			entry:  fakeDirEntry{name: "old", isDir: true},
+			skip:   true,
+		},
+		{
+			name:   "not included",
+			config: &Config{Path: "i18n", Include: []string{"main.json"}},
+			path:   "i18n/en/other.json",
+			entry:  fakeDirEntry{name: "other.json"},
+			skip:   true,
+		},
+		{
+			name:   "include doesn't apply to directories",
+			config: &Config{Path: "i18n", Include: []string{"main.json"}},
+			path:   "i18n/en",
+			entry:  fakeDirEntry{name: "en", isDir: true},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name,
+			func(t *testing.T) {
+				var messages []string
+
+				tc.config.Debug = func(format string, v ...interface{}) {
+					messages = append(messages, format)
+				}
+
+				i18n := New(tc.config)
+				path := filepath.FromSlash(tc.path)
+
+				assert.Equal(t, tc.skip, i18n.skip(path, newFakeDirEntry(tc.entry)))
+				assert.Equal(t, tc.skip, len(messages) == 1)
+			},
+		)
+	}
+}
+
+func Test_I18nValidatePatterns(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, New(&Config{Include: []string{"*.json"}}).validatePatterns())
+	assert.Error(t, New(&Config{Exclude: []string{"["}}).validatePatterns())
+}
+
+func Test_I18nLoadSkipFiles(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	files := map[string]string{
+		"README.md":           "# translations",
+		".DS_Store":           "",
+		"en/.gitkeep":         "",
+		"en/notes.txt":        "notes",
+		"en/skip_filter.json": `[{"id": "skip filter", "message": "Skip filter"}]`,
+	}
+
+	for name, data := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+
+		assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		assert.NoError(t, os.WriteFile(path, []byte(data), 0o600))
+	}
+
+	assert.NoError(t, New(&Config{Path: dir}).Load())
+}
@@ -0,0 +1,622 @@
+//go:build ignore
+
+// Generator of locale data from CLDR: calendars, currency patterns, compact
+// units, list patterns and unit names of all CLDR locales. A locale is
+// written only if its data differs from the data its language falls back to.
+// The CLDR common data archive is downloaded unless a path is given:
+//
+//	go run gen.go -cldr cldr-common-44.0.zip
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/unicode/cldr"
+)
+
+var source = flag.String(
+	"cldr",
+	"https://unicode.org/Public/cldr/44/cldr-common-44.0.zip",
+	"URL or path of the CLDR common data archive",
+)
+
+func main() {
+	flag.Parse()
+
+	data, err := load(*source)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	g := newGenerator(data)
+
+	files := []struct {
+		name   string
+		tables []table
+	}{
+		{name: "dates_data.go", tables: []table{
+			{
+				doc: "Calendar data of the Gregorian calendar from CLDR by language. Month\n" +
+					"names are of the format context, e.g. genitive in Russian, and of the\n" +
+					"stand-alone context, e.g. nominative.",
+				name:   "calendars",
+				typ:    "map[string]*calendar",
+				locale: g.calendar,
+			},
+		}},
+		{name: "numbers_data.go", tables: []table{
+			{
+				doc:    "Standard currency patterns of CLDR by language, `¤` is the symbol and `0`\nis the amount.",
+				name:   "currencyPatterns",
+				typ:    "map[string]string",
+				locale: g.currency,
+			},
+			{
+				doc:    "Short compact units of CLDR by language.",
+				name:   "compactUnits",
+				typ:    "map[string][]compactUnit",
+				locale: g.compact,
+			},
+		}},
+		{name: "lists_data.go", tables: []table{
+			{
+				doc:    "List patterns of CLDR by language and list kind.",
+				name:   "lists",
+				typ:    "map[string][3]listPatterns",
+				locale: g.lists,
+			},
+		}},
+		{name: "units_data.go", tables: []table{
+			{
+				doc:    "Unit names of CLDR by language: abbreviations and full names by plural\ncategory.",
+				name:   "units",
+				typ:    "map[string]map[MeasureUnit][2]map[string]string",
+				locale: g.units,
+			},
+		}},
+	}
+
+	for _, file := range files {
+		var b bytes.Buffer
+
+		b.WriteString("// Code generated by gen.go. DO NOT EDIT.\n\npackage i18n\n")
+
+		for _, t := range file.tables {
+			g.writeTable(&b, t)
+		}
+
+		src, err := format.Source(b.Bytes())
+		if err != nil {
+			log.Fatalf("%v: %v", file.name, err)
+		}
+
+		if err = os.WriteFile(file.name, src, 0o644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// Load the CLDR archive from the URL or path.
+func load(source string) (*cldr.CLDR, error) {
+	var r io.Reader
+
+	if strings.HasPrefix(source, "https://") || strings.HasPrefix(source, "http://") {
+		resp, err := http.Get(source)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("%v: %v", source, resp.Status)
+		}
+
+		r = resp.Body
+	} else {
+		f, err := os.Open(source)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+
+		r = f
+	}
+
+	var d cldr.Decoder
+
+	d.SetDirFilter("main", "supplemental")
+	d.SetSectionFilter("dates", "numbers", "units", "listPatterns")
+
+	return d.DecodeZip(r)
+}
+
+// Generator of locale data.
+type generator struct {
+	data *cldr.CLDR
+	// CLDR locales by BCP 47 tags.
+	locales map[string]string
+	// Parent locales of CLDR which differ from truncation.
+	parents map[string]string
+}
+
+// Create a generator.
+func newGenerator(data *cldr.CLDR) *generator {
+	g := &generator{data: data, locales: make(map[string]string), parents: make(map[string]string)}
+
+	for _, loc := range data.Locales() {
+		if loc == "root" {
+			continue
+		}
+
+		tag, err := language.Parse(strings.ReplaceAll(loc, "_", "-"))
+		if err != nil {
+			log.Printf("skip locale %v: %v", loc, err)
+			continue
+		}
+
+		g.locales[tag.String()] = loc
+	}
+
+	if supplemental := data.Supplemental(); supplemental != nil && supplemental.ParentLocales != nil {
+		for _, p := range supplemental.ParentLocales.ParentLocale {
+			for _, loc := range strings.Fields(p.Locales) {
+				g.parents[loc] = p.Parent
+			}
+		}
+	}
+
+	return g
+}
+
+// Table of locale data. The locale function writes a Go value of the
+// locale data, the value is compared with the data of the parent.
+type table struct {
+	doc    string
+	name   string
+	typ    string
+	locale func(loc string) string
+}
+
+// Write the table with locales which differ from the data they fall back to.
+func (g *generator) writeTable(b *bytes.Buffer, t table) {
+	tags := make([]language.Tag, 0, len(g.locales))
+	for key := range g.locales {
+		tags = append(tags, language.MustParse(key))
+	}
+
+	// Parents go first, so a locale is compared with the written data.
+	sort.Slice(tags, func(a, b int) bool {
+		if depth(tags[a]) != depth(tags[b]) {
+			return depth(tags[a]) < depth(tags[b])
+		}
+
+		return tags[a].String() < tags[b].String()
+	})
+
+	written := make(map[string]string)
+
+	for _, tag := range tags {
+		value := t.locale(g.locales[tag.String()])
+
+		if key, ok := localeKey(tag, func(key string) bool { _, ok := written[key]; return ok }); ok {
+			if written[key] == value {
+				continue
+			}
+		}
+
+		written[tag.String()] = value
+	}
+
+	keys := make([]string, 0, len(written))
+	for key := range written {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	fmt.Fprintf(b, "\n// %v\nvar %v = %v{\n", strings.ReplaceAll(t.doc, "\n", "\n// "), t.name, t.typ)
+
+	for _, key := range keys {
+		fmt.Fprintf(b, "%q: %v,\n", key, written[key])
+	}
+
+	b.WriteString("}\n")
+}
+
+// Get the number of parents of the tag.
+func depth(tag language.Tag) int {
+	n := 0
+	for t := tag; t != language.Und; t = t.Parent() {
+		n++
+	}
+
+	return n
+}
+
+// Get a key of locale data of the language, its parent or base language,
+// the same way as the i18n package does.
+func localeKey(tag language.Tag, has func(key string) bool) (string, bool) {
+	for t := tag.Parent(); t != language.Und; t = t.Parent() {
+		if has(t.String()) {
+			return t.String(), true
+		}
+	}
+
+	base, _ := tag.Base()
+	script, _ := tag.Script()
+
+	if baseScript, _ := language.Make(base.String()).Script(); baseScript == script && has(base.String()) {
+		return base.String(), true
+	}
+
+	return "", false
+}
+
+// Get the parent of the CLDR locale.
+func (g *generator) parent(loc string) string {
+	if parent, ok := g.parents[loc]; ok {
+		return parent
+	}
+
+	if n := strings.LastIndexByte(loc, '_'); n >= 0 {
+		return loc[:n]
+	}
+
+	return "root"
+}
+
+// Get a text of the locale at the first path found in the locale or its
+// parents. The root locale is resolved, so its aliases are followed. Paths
+// are slash separated elements with attribute selectors, e.g.
+// `units/unitLength[type=short]/unit[type=length-meter]`.
+func (g *generator) text(loc string, paths ...string) string {
+	for _, path := range paths {
+		for l := loc; l != "root"; l = g.parent(l) {
+			if ldml := g.data.RawLDML(l); ldml != nil {
+				if s, ok := find(reflect.ValueOf(ldml), path); ok && s != "↑↑↑" {
+					return s
+				}
+			}
+		}
+	}
+
+	root, err := g.data.LDML("root")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, path := range paths {
+		if s, ok := find(reflect.ValueOf(root), path); ok {
+			return s
+		}
+	}
+
+	return ""
+}
+
+// Element of a path with attribute selectors, e.g. `pattern[type=1000][count=one]`.
+var pathRegexp = regexp.MustCompile(`^(\w+)((?:\[\w+=[^\]]*\])*)$`)
+
+// Attribute selector of a path element.
+var selectorRegexp = regexp.MustCompile(`\[(\w+)=([^\]]*)\]`)
+
+// Find a text of the element at the path. Elements with the `alt` attribute
+// are skipped, as well as elements with a type if there is no type selector.
+func find(v reflect.Value, path string) (string, bool) {
+	for _, part := range strings.Split(path, "/") {
+		m := pathRegexp.FindStringSubmatch(part)
+		if m == nil {
+			log.Fatalf("wrong path %v", path)
+		}
+
+		selectors := map[string]string{"alt": "", "type": ""}
+
+		for _, s := range selectorRegexp.FindAllStringSubmatch(m[2], -1) {
+			selectors[s[1]] = s[2]
+		}
+
+		field, ok := xmlField(reflect.Indirect(v), m[1], false)
+		if !ok {
+			return "", false
+		}
+
+		switch field.Kind() {
+		case reflect.Ptr:
+			v = field
+		case reflect.Slice:
+			v = reflect.Value{}
+
+			for n := 0; n < field.Len(); n++ {
+				if matches(field.Index(n), selectors) {
+					v = field.Index(n)
+					break
+				}
+			}
+		}
+
+		if !v.IsValid() || v.IsNil() {
+			return "", false
+		}
+	}
+
+	elem, ok := v.Interface().(cldr.Elem)
+	if !ok {
+		return "", false
+	}
+
+	return elem.GetCommon().Data(), true
+}
+
+// Check attributes of the element.
+func matches(v reflect.Value, selectors map[string]string) bool {
+	if v.IsNil() {
+		return false
+	}
+
+	for name, value := range selectors {
+		attr, _ := xmlField(v.Elem(), name, true)
+		if attr.IsValid() && attr.String() != value || !attr.IsValid() && value != "" {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Get a field of the XML element or attribute, embedded fields included.
+func xmlField(v reflect.Value, name string, attr bool) (reflect.Value, bool) {
+	if v.Kind() != reflect.Struct {
+		return reflect.Value{}, false
+	}
+
+	for n := 0; n < v.NumField(); n++ {
+		f := v.Type().Field(n)
+
+		if f.Anonymous {
+			if field, ok := xmlField(v.Field(n), name, attr); ok {
+				return field, true
+			}
+
+			continue
+		}
+
+		tag := strings.Split(f.Tag.Get("xml"), ",")
+		if tag[0] == name && (len(tag) > 1 && tag[1] == "attr") == attr && f.IsExported() {
+			return v.Field(n), true
+		}
+	}
+
+	return reflect.Value{}, false
+}
+
+// Plural categories of CLDR.
+var categories = []string{"zero", "one", "two", "few", "many", "other"}
+
+// Write texts by plural category of the path with the `[count=<category>]`
+// selector, fallback paths are used for missing categories.
+func (g *generator) plural(loc string, paths ...string) string {
+	var b strings.Builder
+
+	b.WriteString("{")
+
+	for _, category := range categories {
+		counted := make([]string, len(paths))
+		for n, path := range paths {
+			counted[n] = path + "[count=" + category + "]"
+		}
+
+		if text := g.text(loc, counted...); text != "" {
+			fmt.Fprintf(&b, "%q: %q, ", category, text)
+		}
+	}
+
+	b.WriteString("}")
+
+	return b.String()
+}
+
+// Write texts of the paths as a Go array.
+func (g *generator) array(loc, typ string, paths func(n int) []string, size int) string {
+	values := make([]string, size)
+	for n := range values {
+		values[n] = strconv.Quote(g.text(loc, paths(n)...))
+	}
+
+	return typ + "{" + strings.Join(values, ", ") + "}"
+}
+
+// Path of the Gregorian calendar.
+const gregorian = "dates/calendars/calendar[type=gregorian]/"
+
+// CLDR styles in order of Short, Medium, Long and Full.
+var styles = []string{"short", "medium", "long", "full"}
+
+// Write calendar data of the locale.
+func (g *generator) calendar(loc string) string {
+	months := func(context, width string) func(n int) []string {
+		return func(n int) []string {
+			path := "months/monthContext[type=%v]/monthWidth[type=%v]/month[type=%v]"
+
+			return []string{
+				gregorian + fmt.Sprintf(path, context, width, n+1),
+				gregorian + fmt.Sprintf(path, "format", width, n+1),
+			}
+		}
+	}
+
+	weekdays := func(width string) func(n int) []string {
+		return func(n int) []string {
+			path := "days/dayContext[type=format]/dayWidth[type=%v]/day[type=%v]"
+			day := []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}[n]
+
+			return []string{
+				gregorian + fmt.Sprintf(path, width, day),
+				gregorian + fmt.Sprintf(path, "abbreviated", day),
+			}
+		}
+	}
+
+	formats := func(kind string) func(n int) []string {
+		return func(n int) []string {
+			path := "%[1]vFormats/%[1]vFormatLength[type=%[2]v]/%[1]vFormat/pattern"
+
+			return []string{gregorian + fmt.Sprintf(path, kind, styles[n])}
+		}
+	}
+
+	var b strings.Builder
+
+	b.WriteString("{\n")
+
+	formatMonths := [2]string{
+		g.array(loc, "[12]string", months("format", "wide"), 12),
+		g.array(loc, "[12]string", months("format", "abbreviated"), 12),
+	}
+	standalone := [2]string{
+		g.array(loc, "[12]string", months("stand-alone", "wide"), 12),
+		g.array(loc, "[12]string", months("stand-alone", "abbreviated"), 12),
+	}
+
+	fmt.Fprintf(&b, "months: %v,\nmonthsShort: %v,\n", formatMonths[0], formatMonths[1])
+
+	if standalone[0] != formatMonths[0] {
+		fmt.Fprintf(&b, "standaloneMonths: %v,\n", standalone[0])
+	}
+
+	if standalone[1] != formatMonths[1] {
+		fmt.Fprintf(&b, "standaloneMonthsShort: %v,\n", standalone[1])
+	}
+
+	fmt.Fprintf(&b, "weekdays: %v,\n", g.array(loc, "[7]string", weekdays("wide"), 7))
+	fmt.Fprintf(&b, "weekdaysShort: %v,\n", g.array(loc, "[7]string", weekdays("abbreviated"), 7))
+	fmt.Fprintf(&b, "dayPeriods: %v,\n", g.array(loc, "[2]string", func(n int) []string {
+		path := "dayPeriods/dayPeriodContext[type=format]/dayPeriodWidth[type=abbreviated]/dayPeriod[type=%v]"
+
+		return []string{gregorian + fmt.Sprintf(path, []string{"am", "pm"}[n])}
+	}, 2))
+	fmt.Fprintf(&b, "date: %v,\n", g.array(loc, "[4]string", formats("date"), 4))
+	fmt.Fprintf(&b, "time: %v,\n", g.array(loc, "[4]string", formats("time"), 4))
+	fmt.Fprintf(&b, "dateTime: %v,\n", g.array(loc, "[4]string", formats("dateTime"), 4))
+
+	fmt.Fprintf(&b, "relative: relativeData{\nnow: %q,\n", g.text(loc, "dates/fields/field[type=second]/relative[type=0]"))
+
+	for _, tense := range []string{"future", "past"} {
+		fmt.Fprintf(&b, "%v: map[string]map[string]string{\n", tense)
+
+		for _, unit := range []string{"year", "month", "week", "day", "hour", "minute", "second"} {
+			path := fmt.Sprintf("dates/fields/field[type=%v]/relativeTime[type=%v]/relativeTimePattern", unit, tense)
+			fmt.Fprintf(&b, "%q: %v,\n", unit, g.plural(loc, path))
+		}
+
+		b.WriteString("},\n")
+	}
+
+	b.WriteString("},\n}")
+
+	return b.String()
+}
+
+// Number part of a CLDR number pattern.
+var numberRegexp = regexp.MustCompile(`[#0][#0,.]*`)
+
+// Write the standard currency pattern of the locale.
+func (g *generator) currency(loc string) string {
+	pattern := g.text(loc, "numbers/currencyFormats[numberSystem=latn]/currencyFormatLength/currencyFormat[type=standard]/pattern")
+	pattern, _, _ = strings.Cut(pattern, ";")
+
+	return strconv.Quote(numberRegexp.ReplaceAllString(pattern, "0"))
+}
+
+// Write short compact units of the locale: patterns of powers of ten with a
+// single digit, other powers use the same unit with more digits.
+func (g *generator) compact(loc string) string {
+	var units []string
+
+	for power := 3; power <= 14; power++ {
+		path := "numbers/decimalFormats[numberSystem=latn]/decimalFormatLength[type=short]/decimalFormat[type=standard]" +
+			"/pattern[type=1" + strings.Repeat("0", power) + "][count=other]"
+
+		pattern := g.text(loc, path)
+		if pattern == "" || pattern == "0" || strings.Count(pattern, "0") != 1 {
+			continue
+		}
+
+		pattern = strings.ReplaceAll(strings.ReplaceAll(pattern, "''", "\x00"), "'", "")
+		units = append(units, fmt.Sprintf("{%v, %q}", power, strings.ReplaceAll(pattern, "\x00", "'")))
+	}
+
+	return "{" + strings.Join(units, ", ") + "}"
+}
+
+// Write list patterns of the locale by list kind.
+func (g *generator) lists(loc string) string {
+	var b strings.Builder
+
+	b.WriteString("{\n")
+
+	for _, kind := range []string{"listPattern", "listPattern[type=or]", "listPattern[type=unit]"} {
+		part := func(typ string) string {
+			return g.text(loc, "listPatterns/"+kind+"/listPatternPart[type="+typ+"]")
+		}
+
+		fmt.Fprintf(
+			&b,
+			"{start: %q, middle: %q, end: %q, two: %q},\n",
+			part("start"), part("middle"), part("end"), part("2"),
+		)
+	}
+
+	b.WriteString("}")
+
+	return b.String()
+}
+
+// Go names of CLDR units.
+var unitNames = []struct {
+	name string
+	cldr string
+}{
+	{name: "Byte", cldr: "digital-byte"},
+	{name: "Kilobyte", cldr: "digital-kilobyte"},
+	{name: "Megabyte", cldr: "digital-megabyte"},
+	{name: "Gigabyte", cldr: "digital-gigabyte"},
+	{name: "Terabyte", cldr: "digital-terabyte"},
+	{name: "Meter", cldr: "length-meter"},
+	{name: "Kilometer", cldr: "length-kilometer"},
+	{name: "Mile", cldr: "length-mile"},
+	{name: "Day", cldr: "duration-day"},
+	{name: "Hour", cldr: "duration-hour"},
+	{name: "Minute", cldr: "duration-minute"},
+	{name: "Second", cldr: "duration-second"},
+}
+
+// Write unit names of the locale, long names fall back to abbreviations.
+func (g *generator) units(loc string) string {
+	var b strings.Builder
+
+	b.WriteString("{\n")
+
+	for _, unit := range unitNames {
+		short := "units/unitLength[type=short]/unit[type=" + unit.cldr + "]/unitPattern"
+		long := "units/unitLength[type=long]/unit[type=" + unit.cldr + "]/unitPattern"
+
+		fmt.Fprintf(&b, "%v: {%v, %v},\n", unit.name, g.plural(loc, short), g.plural(loc, long, short))
+	}
+
+	b.WriteString("}")
+
+	return b.String()
+}
@@ -1,39 +1,78 @@
 package i18n
 
 import (
-	"encoding/json"
+	"context"
+	"fmt"
 	"io/fs"
-	"io/ioutil"
-	"os"
 	"path/filepath"
 	"reflect"
 	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
 
 	"golang.org/x/text/feature/plural"
 	"golang.org/x/text/language"
 	"golang.org/x/text/message"
+	"golang.org/x/text/message/catalog"
 )
 
 // Config of i18n.
 type Config struct {
 	// Path to the languages folder.
 	// File or directory name use as language tag.
+	// If the path is a file, it's loaded as a bundle with all languages.
 	Path string
+	// FS is a file system with the languages folder, e.g. embed.FS. Path is
+	// a slash separated path in the file system, the root if empty. Files
+	// are loaded from the OS file system by default.
+	FS fs.FS
 	// Fallback language.
 	Fallback language.Tag
+	// Include is a list of glob patterns of translation files to load. A
+	// pattern without a slash is matched against the file name, otherwise
+	// against the path relative to Path. All files are loaded when empty.
+	Include []string
+	// Exclude is a list of glob patterns of files and directories to skip.
+	// Hidden files and files with unknown extensions are always skipped.
+	Exclude []string
+	// Debug receives diagnostic messages, e.g. about skipped files.
+	Debug func(format string, v ...interface{})
+	// Sources of messages layered on top of translation files, see
+	// I18n.LoadSources.
+	Sources []Source
+	// Domain gets a translation domain of a file from its slash separated
+	// path relative to Path, e.g. DomainFromPath, see I18n.Domain. Files
+	// have no domain by default.
+	Domain func(path string) string
+	// Layout gets language tags from translation file paths, e.g. LayoutFile
+	// or LayoutName. By default every entry of the languages folder is a
+	// language: a folder or a file named by the tag, and a wrong name or a
+	// duplicate tag is an error.
+	Layout Layout
 }
 
 // I18n data.
 type I18n struct {
+	mu        sync.RWMutex
 	languages []lang
 	printer   map[language.Tag]*message.Printer
+	index     *index
 	config    *Config
+	// Messages of translation files.
+	files *index
+	// Messages of sources.
+	overrides sourceMessages
+	// Translation domains by name.
+	domains map[string]*domain
 }
 
 // Language properties.
 type lang struct {
 	tag   language.Tag
 	entry fs.DirEntry
+	// Files of the language found with Config.Layout.
+	files []string
 }
 
 // New instance of i18n.
@@ -41,16 +80,58 @@ func New(cfg *Config) *I18n {
 	return &I18n{
 		languages: make([]lang, 0),
 		printer:   make(map[language.Tag]*message.Printer),
+		index:     newIndex(),
 		config:    cfg,
+		domains:   make(map[string]*domain),
 	}
 }
 
 // Load all locales.
 func (i *I18n) Load() (err error) {
+	var info fs.FileInfo
+
+	if err = i.validatePatterns(); err != nil {
+		return
+	}
+
+	if info, err = i.stat(i.config.Path); err != nil {
+		return
+	}
+
+	if info.IsDir() {
+		err = i.loadDir()
+	} else {
+		err = i.loadBundle()
+	}
+
+	if err != nil {
+		return
+	}
+
+	i.files = i.index.clone()
+	for _, dom := range i.domains {
+		dom.files = dom.index.clone()
+	}
+
+	err = i.LoadSources(context.Background())
+
+	return
+}
+
+// Load the languages folder.
+func (i *I18n) loadDir() (err error) {
 	var files []fs.DirEntry
 
+	if i.config.Layout != nil {
+		if err = i.layoutTags(); err != nil {
+			return
+		}
+
+		return i.load()
+	}
+
 	// Read locales directory.
-	files, err = os.ReadDir(i.config.Path)
+	files, err = i.readDir(i.config.Path)
 	if err != nil {
 		return
 	}
@@ -66,7 +147,11 @@ func (i *I18n) Load() (err error) {
 // Printer implements language-specific formatted I/O analogous to the fmt
 // package.
 func (i *I18n) Printer(tag language.Tag) *message.Printer {
-	if printer, ok := i.printer[tag]; ok {
+	i.mu.RLock()
+	printer, ok := i.printer[tag]
+	i.mu.RUnlock()
+
+	if ok {
 		return printer
 	}
 
@@ -79,15 +164,49 @@ func (i *I18n) tag(dir []fs.DirEntry) (err error) {
 	var ok bool
 
 	for _, lang.entry = range dir {
-		lang.tag, err = language.Parse(lang.entry.Name())
+		path := filepath.Join(i.config.Path, lang.entry.Name())
+
+		if i.skip(path, lang.entry) {
+			continue
+		}
+
+		lang.tag, ok, err = entryTag(lang.entry)
 		if err != nil {
 			return
 		}
 
-		if _, ok = contains(i.languages, lang.tag); ok {
+		if !ok {
+			i.debugf("skip without language tag %v", path)
+			continue
+		}
+
+		n, exists := contains(i.languages, lang.tag)
+
+		// Files with the tag in the name are merged, e.g.
+		// `messages_ru.properties` and `errors_ru.properties`.
+		if !lang.entry.IsDir() && isNamedFile(lang.entry.Name()) {
+			if !exists {
+				i.languages = append(i.languages, lang)
+				i.printer[lang.tag] = message.NewPrinter(lang.tag)
+
+				n = len(i.languages) - 1
+				i.languages[n].entry = nil
+			}
+
+			i.languages[n].files = append(i.languages[n].files, path)
+
+			continue
+		}
+
+		if exists && i.languages[n].entry != nil {
 			return &ErrorLanguageTagAlreadyExists{Tag: lang.tag}
 		}
 
+		if exists {
+			i.languages[n].entry = lang.entry
+			continue
+		}
+
 		i.languages = append(i.languages, lang)
 
 		i.printer[lang.tag] = message.NewPrinter(lang.tag)
@@ -96,6 +215,28 @@ func (i *I18n) tag(dir []fs.DirEntry) (err error) {
 	return i.fallback()
 }
 
+// Get a language tag of a languages folder entry: the folder name, the file
+// name without extension, or a tag of the file name provided by the decoder.
+func entryTag(entry fs.DirEntry) (language.Tag, bool, error) {
+	name := entry.Name()
+
+	if !entry.IsDir() {
+		if d, ok := decoderFor(filepath.Ext(name)); ok {
+			if d, ok := d.(namedDecoder); ok {
+				tag, ok := d.tagFromName(name)
+
+				return tag, ok, nil
+			}
+		}
+
+		name = strings.TrimSuffix(name, filepath.Ext(name))
+	}
+
+	tag, err := language.Parse(name)
+
+	return tag, err == nil, err
+}
+
 // Test fallback language.
 func (i *I18n) fallback() error {
 	if i.config.Fallback == language.Und {
@@ -112,8 +253,16 @@ func (i *I18n) fallback() error {
 // Load all languages files.
 func (i *I18n) load() (err error) {
 	for _, lang := range i.languages {
-		if err = loadLanguage(lang.tag, lang.entry, i.config.Path); err != nil {
-			return
+		if lang.entry != nil {
+			if err = i.loadLanguage(lang.tag, lang.entry, i.config.Path); err != nil {
+				return
+			}
+		}
+
+		for _, path := range lang.files {
+			if err = i.loadFile(lang.tag, path); err != nil {
+				return
+			}
 		}
 	}
 
@@ -127,10 +276,13 @@ func (i *I18n) load() (err error) {
 type translation struct {
 	tag      language.Tag
 	filePath string
+	index    *index
+	// Domain of the file, nil if the file has no domain.
+	domain *domain
 }
 
 // Load language files.
-func loadLanguage(
+func (i *I18n) loadLanguage(
 	tag language.Tag,
 	file fs.DirEntry,
 	rootPath string,
@@ -138,21 +290,25 @@ func loadLanguage(
 	currentPath := filepath.Join(rootPath, file.Name())
 
 	if file.IsDir() {
-		files, err := os.ReadDir(currentPath)
+		files, err := i.readDir(currentPath)
 		if err != nil {
 			return err
 		}
 
 		for _, entry := range files {
+			if i.skip(filepath.Join(currentPath, entry.Name()), entry) {
+				continue
+			}
+
 			if entry.IsDir() {
-				loadLanguage(tag, entry, currentPath)
+				if err = i.loadLanguage(tag, entry, currentPath); err != nil {
+					return err
+				}
+
 				continue
 			}
 
-			err = (&translation{
-				tag:      tag,
-				filePath: filepath.Join(currentPath, entry.Name()),
-			}).loadLanguageFile()
+			err = i.loadFile(tag, filepath.Join(currentPath, entry.Name()))
 			if err != nil {
 				return err
 			}
@@ -161,31 +317,45 @@ func loadLanguage(
 		return nil
 	}
 
-	return (&translation{tag: tag, filePath: currentPath}).loadLanguageFile()
+	return i.loadFile(tag, currentPath)
 }
 
-// Append language file.
-func (i *translation) loadLanguageFile() error {
-	b, err := ioutil.ReadFile(i.filePath)
+// Load a language file.
+func (i *I18n) loadFile(tag language.Tag, path string) error {
+	b, err := i.readFile(path)
 	if err != nil {
 		return err
 	}
 
-	return i.append(b)
+	return i.newTranslation(tag, path).append(b)
 }
 
 // Translation message properties.
 type translationMessage struct {
-	ID      string      `json:"id"`
-	Message *string     `json:"message,omitempty"`
-	Rules   interface{} `json:"rules,omitempty"`
+	ID      string      `json:"id" yaml:"id"`
+	Message *string     `json:"message,omitempty" yaml:"message,omitempty"`
+	Rules   interface{} `json:"rules,omitempty" yaml:"rules,omitempty"`
+	// Description of the message for translators.
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+	// Context where the message is used.
+	Context string `json:"context,omitempty" yaml:"context,omitempty"`
+	// Maximum length of the message in characters.
+	MaxLength int `json:"maxLength,omitempty" yaml:"maxLength,omitempty"`
+	// Hash of the source message the translation is made from.
+	Hash string `json:"hash,omitempty" yaml:"hash,omitempty"`
 }
 
-// Append language messages.
-func (i *translation) append(b []byte) (err error) {
-	var m []translationMessage
-	if err = json.Unmarshal(b, &m); err != nil {
-		return
+// Append language messages decoded by the decoder of the file extension,
+// JSON without a file.
+func (i *translation) append(b []byte) error {
+	d, ok := decoderFor(filepath.Ext(i.filePath))
+	if !ok {
+		return &ErrorFormatNotSupported{Format: Format(filepath.Ext(i.filePath))}
+	}
+
+	m, err := decodeLanguage(d, i.tag, b)
+	if err != nil {
+		return err
 	}
 
 	return i.loadMessages(m)
@@ -201,6 +371,8 @@ func (i *translation) loadMessages(m []translationMessage) (err error) {
 		if err = i.loadMessage(message); err != nil {
 			return
 		}
+
+		i.index.add(i.tag, message)
 	}
 
 	return
@@ -234,6 +406,51 @@ func (i *translation) validateMessage(message translationMessage) error {
 		}
 	}
 
+	return i.validateLength(message)
+}
+
+// Validate message length.
+func (i *translation) validateLength(message translationMessage) error {
+	if message.MaxLength < 0 {
+		return &ErrorMessageValidate{
+			Tag:       i.tag,
+			FilePath:  i.filePath,
+			MessageID: message.ID,
+			Message:   "`maxLength` field should be positive",
+		}
+	}
+
+	if message.MaxLength == 0 {
+		return nil
+	}
+
+	texts := make([]string, 0)
+
+	if message.Message != nil {
+		texts = append(texts, *message.Message)
+	}
+
+	for _, arg := range pluralArguments(message.Rules) {
+		for _, text := range arg.messages {
+			texts = append(texts, text)
+		}
+	}
+
+	for _, text := range texts {
+		if utf8.RuneCountInString(text) > message.MaxLength {
+			return &ErrorMessageValidate{
+				Tag:       i.tag,
+				FilePath:  i.filePath,
+				MessageID: message.ID,
+				Message: fmt.Sprintf(
+					"message `%v` is longer than %v characters",
+					text,
+					message.MaxLength,
+				),
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -243,7 +460,17 @@ func (i *translation) loadMessage(m translationMessage) error {
 		return i.loadRules(m)
 	}
 
-	return message.SetString(i.tag, m.ID, *m.Message)
+	return i.set(m.ID, catalog.String(*m.Message))
+}
+
+// Set the message in the domain catalog, or in the default catalog if the
+// translation has no domain.
+func (i *translation) set(id string, msg ...catalog.Message) error {
+	if i.domain != nil {
+		return i.domain.catalog.Set(i.tag, id, msg...)
+	}
+
+	return message.Set(i.tag, id, msg...)
 }
 
 // Load translation rules.
@@ -258,39 +485,58 @@ func (i *translation) loadRules(m translationMessage) (err error) {
 	}
 
 	value := reflect.ValueOf(m.Rules)
-	iter := value.MapRange()
 
 	// The arg-th substitution argument
 	arg := 1
 	msg := make([]interface{}, 0)
 
-	for iter.Next() {
-		if iter.Value().Elem().Type().Kind() == reflect.Map {
-			arg, err = strconv.Atoi(iter.Key().String())
+	// Cases are matched in order, so selectors are sorted with "other" last.
+	for _, key := range selectorKeys(value) {
+		item := value.MapIndex(key).Elem()
+
+		if !item.IsValid() {
+			return i.ruleError(m, key.String())
+		}
+
+		if item.Kind() == reflect.Map {
+			var n int
+
+			n, err = strconv.Atoi(key.String())
 			if err != nil {
-				return
+				return &ErrorMessageValidate{
+					Tag:       i.tag,
+					FilePath:  i.filePath,
+					MessageID: m.ID,
+					Message:   fmt.Sprintf("argument `%v` of rules should be a number", key),
+				}
 			}
 
-			subIter := iter.Value().Elem().MapRange()
+			sub := make([]interface{}, 0)
 
-			for subIter.Next() {
-				msg = append(msg, subIter.Key().String(), subIter.Value().Interface())
+			for _, subKey := range selectorKeys(item) {
+				text := item.MapIndex(subKey)
+				if text.Kind() == reflect.Interface {
+					text = text.Elem()
+				}
+
+				if !text.IsValid() {
+					return i.ruleError(m, subKey.String())
+				}
+
+				sub = append(sub, subKey.String(), text.Interface())
 			}
 
-			err = message.Set(i.tag, m.ID, plural.Selectf(arg, "", msg...))
+			err = i.set(m.ID, plural.Selectf(n, "", sub...))
 			if err != nil {
 				return
 			}
-
-			// Reset messages.
-			msg = nil
 		} else {
-			msg = append(msg, iter.Key().String(), iter.Value().Interface())
+			msg = append(msg, key.String(), item.Interface())
 		}
 	}
 
 	if len(msg) > 0 {
-		err = message.Set(i.tag, m.ID, plural.Selectf(arg, "", msg...))
+		err = i.set(m.ID, plural.Selectf(arg, "", msg...))
 		if err != nil {
 			return
 		}
@@ -298,3 +544,30 @@ func (i *translation) loadRules(m translationMessage) (err error) {
 
 	return
 }
+
+// Report a rule without text.
+func (i *translation) ruleError(m translationMessage, selector string) error {
+	return &ErrorMessageValidate{
+		Tag:       i.tag,
+		FilePath:  i.filePath,
+		MessageID: m.ID,
+		Message:   fmt.Sprintf("rule `%v` has no text", selector),
+	}
+}
+
+// Get keys of the rules map sorted as plural selectors.
+func selectorKeys(v reflect.Value) []reflect.Value {
+	keys := make([]string, 0, v.Len())
+	for _, key := range v.MapKeys() {
+		keys = append(keys, key.String())
+	}
+
+	sortSelectors(keys)
+
+	result := make([]reflect.Value, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, reflect.ValueOf(key))
+	}
+
+	return result
+}
@@ -233,6 +233,7 @@ func Test_TranslationAppend(t *testing.T) {
 
 	testCases := []struct {
 		name string
+		path string
 		data string
 		err  bool
 	}{
@@ -240,18 +241,32 @@ func Test_TranslationAppend(t *testing.T) {
 			name: "successfully",
 			data: "[{\"id\": \"message id\", \"message\": \"message text\"}]",
 		},
+		{
+			name: "nested object",
+			data: "{\"auth\": {\"login\": \"Log in\"}}",
+		},
 		{
 			name: "wrong format",
 			err:  true,
 		},
+		{
+			name: "yaml file",
+			path: "en.yaml",
+			data: "- id: message id\n  message: message text\n",
+		},
+		{
+			name: "unknown extension",
+			path: "en.txt",
+			data: "message id = message text",
+			err:  true,
+		},
 	}
 
-	tr := &translation{tag: language.English}
-
 	for _, tc := range testCases {
 		t.Run(
 			tc.name,
 			func(t *testing.T) {
+				tr := &translation{tag: language.English, filePath: tc.path}
 				err := tr.append([]byte(tc.data))
 
 				if tc.err {
@@ -365,6 +380,44 @@ func Test_TranslationValidateMessage(t *testing.T) {
             "many": "есть %d ящиков с яблоками"
           }
         }
+      }`,
+			err: true,
+		},
+		{
+			name: "message is shorter than max length",
+			message: `{
+        "id": "id",
+        "message": "яблоко",
+        "maxLength": 6
+      }`,
+		},
+		{
+			name: "message is longer than max length",
+			message: `{
+        "id": "id",
+        "message": "яблоко",
+        "maxLength": 5
+      }`,
+			err: true,
+		},
+		{
+			name: "rule is longer than max length",
+			message: `{
+        "id": "id",
+        "rules": {
+          "one": "яблоко",
+          "many": "яблок"
+        },
+        "maxLength": 5
+      }`,
+			err: true,
+		},
+		{
+			name: "negative max length",
+			message: `{
+        "id": "id",
+        "message": "яблоко",
+        "maxLength": -1
       }`,
 			err: true,
 		},
@@ -462,6 +515,26 @@ func Test_TranslationLoadMessage(t *testing.T) {
 	}
 }
 
+func Test_TranslationLoadRulesExactBeforeComparison(t *testing.T) {
+	t.Parallel()
+
+	tr := &translation{tag: language.English}
+	p := message.NewPrinter(language.English)
+
+	assert.NoError(t, tr.loadRules(translationMessage{
+		ID: "selectors %d files",
+		Rules: map[string]interface{}{
+			"=0":    "none",
+			"<5":    "few %d",
+			"other": "many %d",
+		},
+	}))
+
+	assert.Equal(t, "none", p.Sprintf("selectors %d files", 0))
+	assert.Equal(t, "few 3", p.Sprintf("selectors %d files", 3))
+	assert.Equal(t, "many 7", p.Sprintf("selectors %d files", 7))
+}
+
 func Test_TranslationLoadRules(t *testing.T) {
 	t.Parallel()
 
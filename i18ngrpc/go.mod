@@ -0,0 +1,24 @@
+module github.com/yarigo/i18n/v2/i18ngrpc
+
+go 1.18
+
+require (
+	github.com/stretchr/testify v1.7.1
+	github.com/yarigo/i18n/v2 v2.0.0-20261019001344-1d2b512a7ed2
+	golang.org/x/text v0.9.0
+	google.golang.org/grpc v1.57.2
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.0 // indirect
+	github.com/golang/protobuf v1.5.3 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	golang.org/x/net v0.9.0 // indirect
+	golang.org/x/sys v0.7.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20230525234030-28d5490b6b19 // indirect
+	google.golang.org/protobuf v1.30.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+// The module is developed with the i18n module of the repository.
+replace github.com/yarigo/i18n/v2 => ../
@@ -0,0 +1,138 @@
+// Package i18ngrpc provides gRPC interceptors that propagate the caller's
+// language through request metadata.
+package i18ngrpc
+
+import (
+	"context"
+
+	"github.com/yarigo/i18n/v2"
+	"golang.org/x/text/language"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// MetadataKey is a metadata key with the caller's language tag.
+const MetadataKey = "locale"
+
+// Metadata key with preferred languages in Accept-Language format.
+const acceptLanguageKey = "accept-language"
+
+// UnaryServerInterceptor resolves the caller's language from incoming
+// metadata and stores the language and its printer in the context, see
+// i18n.FromContext and i18n.LanguageFromContext.
+func UnaryServerInterceptor(t *i18n.I18n) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		_ *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		return handler(newContext(ctx, t), req)
+	}
+}
+
+// StreamServerInterceptor resolves the caller's language from incoming
+// metadata and stores the language and its printer in the stream context.
+func StreamServerInterceptor(t *i18n.I18n) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		_ *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		return handler(srv, &serverStream{
+			ServerStream: stream,
+			ctx:          newContext(stream.Context(), t),
+		})
+	}
+}
+
+// UnaryClientInterceptor forwards the language stored in the context with
+// i18n.NewLanguageContext to the server.
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		return invoker(outgoingContext(ctx), method, req, reply, cc, opts...)
+	}
+}
+
+// StreamClientInterceptor forwards the language stored in the context with
+// i18n.NewLanguageContext to the server.
+func StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(
+		ctx context.Context,
+		desc *grpc.StreamDesc,
+		cc *grpc.ClientConn,
+		method string,
+		streamer grpc.Streamer,
+		opts ...grpc.CallOption,
+	) (grpc.ClientStream, error) {
+		return streamer(outgoingContext(ctx), desc, cc, method, opts...)
+	}
+}
+
+// Server stream with the replaced context.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context returns the context for this stream.
+func (i *serverStream) Context() context.Context {
+	return i.ctx
+}
+
+// Create a context with the caller's language and its printer.
+func newContext(ctx context.Context, t *i18n.I18n) context.Context {
+	tag := resolve(ctx, t)
+
+	return i18n.NewLanguageContext(i18n.NewContext(ctx, t.Printer(tag)), tag)
+}
+
+// Resolve the caller's language from the locale, then from the
+// accept-language metadata, otherwise the fallback language is used.
+func resolve(ctx context.Context, t *i18n.I18n) language.Tag {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	for _, value := range md.Get(MetadataKey) {
+		tag, err := language.Parse(value)
+		if err != nil {
+			continue
+		}
+
+		if tag, ok := t.Match(tag); ok {
+			return tag
+		}
+	}
+
+	for _, value := range md.Get(acceptLanguageKey) {
+		tags, _, err := language.ParseAcceptLanguage(value)
+		if err != nil {
+			continue
+		}
+
+		if tag, ok := t.Match(tags...); ok {
+			return tag
+		}
+	}
+
+	tag, _ := t.Match()
+
+	return tag
+}
+
+// Add the context language to outgoing metadata.
+func outgoingContext(ctx context.Context) context.Context {
+	tag, ok := i18n.LanguageFromContext(ctx)
+	if !ok || tag == language.Und {
+		return ctx
+	}
+
+	return metadata.AppendToOutgoingContext(ctx, MetadataKey, tag.String())
+}
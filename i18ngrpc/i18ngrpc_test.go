@@ -0,0 +1,156 @@
+package i18ngrpc
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yarigo/i18n/v2"
+	"golang.org/x/text/language"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// Translated messages received by the server.
+type received struct {
+	unary  chan string
+	stream chan string
+}
+
+// Start a server with a health service and connect to it.
+func dial(t *testing.T) (grpc_health_v1.HealthClient, *received) {
+	path := filepath.Join(t.TempDir(), "bundle.yaml")
+	data := "grpc hello:\n  en: Hello\n  ru: Привет\n  de: Hallo\n"
+
+	assert.NoError(t, os.WriteFile(path, []byte(data), 0o600))
+
+	tr := i18n.New(&i18n.Config{Path: path, Fallback: language.English})
+	assert.NoError(t, tr.Load())
+
+	r := &received{unary: make(chan string, 1), stream: make(chan string, 1)}
+
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			UnaryServerInterceptor(tr),
+			func(
+				ctx context.Context,
+				req interface{},
+				_ *grpc.UnaryServerInfo,
+				handler grpc.UnaryHandler,
+			) (interface{}, error) {
+				r.unary <- i18n.FromContext(ctx).Sprintf("grpc hello")
+				return handler(ctx, req)
+			},
+		),
+		grpc.ChainStreamInterceptor(
+			StreamServerInterceptor(tr),
+			func(
+				srv interface{},
+				stream grpc.ServerStream,
+				_ *grpc.StreamServerInfo,
+				handler grpc.StreamHandler,
+			) error {
+				r.stream <- i18n.FromContext(stream.Context()).Sprintf("grpc hello")
+				return nil
+			},
+		),
+	)
+	grpc_health_v1.RegisterHealthServer(server, health.NewServer())
+
+	listener := bufconn.Listen(1024 * 1024)
+
+	go func() {
+		_ = server.Serve(listener)
+	}()
+
+	conn, err := grpc.Dial(
+		"bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(UnaryClientInterceptor()),
+		grpc.WithStreamInterceptor(StreamClientInterceptor()),
+	)
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		conn.Close()
+		server.Stop()
+	})
+
+	return grpc_health_v1.NewHealthClient(conn), r
+}
+
+func Test_Interceptors(t *testing.T) {
+	t.Parallel()
+
+	client, r := dial(t)
+
+	testCases := []struct {
+		name string
+		ctx  func() context.Context
+		out  string
+	}{
+		{
+			name: "caller's language",
+			ctx: func() context.Context {
+				return i18n.NewLanguageContext(context.Background(), language.Russian)
+			},
+			out: "Привет",
+		},
+		{
+			name: "regional caller's language",
+			ctx: func() context.Context {
+				return i18n.NewLanguageContext(context.Background(), language.MustParse("de-AT"))
+			},
+			out: "Hallo",
+		},
+		{
+			name: "accept-language",
+			ctx: func() context.Context {
+				return metadata.AppendToOutgoingContext(
+					context.Background(),
+					"accept-language", "fr, ru;q=0.9",
+				)
+			},
+			out: "Привет",
+		},
+		{
+			name: "fallback language",
+			ctx: func() context.Context {
+				return i18n.NewLanguageContext(context.Background(), language.French)
+			},
+			out: "Hello",
+		},
+		{
+			name: "without language",
+			ctx:  context.Background,
+			out:  "Hello",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name,
+			func(t *testing.T) {
+				_, err := client.Check(tc.ctx(), &grpc_health_v1.HealthCheckRequest{})
+				assert.NoError(t, err)
+				assert.Equal(t, tc.out, <-r.unary)
+
+				stream, err := client.Watch(tc.ctx(), &grpc_health_v1.HealthCheckRequest{})
+				assert.NoError(t, err)
+
+				_, _ = stream.Recv()
+				assert.Equal(t, tc.out, <-r.stream)
+			},
+		)
+	}
+}
@@ -0,0 +1,149 @@
+// Package i18nhttp provides net/http middleware that negotiates the request
+// language and injects its printer into the request context.
+package i18nhttp
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/yarigo/i18n/v2"
+	"golang.org/x/text/language"
+)
+
+// Resolver returns the preferred languages of the request.
+type Resolver func(r *http.Request) []language.Tag
+
+// Query resolves a language from the query parameter, e.g. `?lang=ru`.
+func Query(name string) Resolver {
+	return func(r *http.Request) []language.Tag {
+		return parse(r.URL.Query().Get(name))
+	}
+}
+
+// Cookie resolves a language from the cookie value.
+func Cookie(name string) Resolver {
+	return func(r *http.Request) []language.Tag {
+		Vary(r, "Cookie")
+
+		cookie, err := r.Cookie(name)
+		if err != nil {
+			return nil
+		}
+
+		return parse(cookie.Value)
+	}
+}
+
+// Header resolves languages from the Accept-Language header.
+func Header() Resolver {
+	return func(r *http.Request) []language.Tag {
+		Vary(r, "Accept-Language")
+
+		tags, _, err := language.ParseAcceptLanguage(r.Header.Get("Accept-Language"))
+		if err != nil {
+			return nil
+		}
+
+		return tags
+	}
+}
+
+// PathPrefix resolves a language from the first path segment, e.g.
+// `/ru/about`. The path is not changed.
+func PathPrefix() Resolver {
+	return func(r *http.Request) []language.Tag {
+		segment := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"), "/", 2)[0]
+
+		return parse(segment)
+	}
+}
+
+// Vary adds the request header to the Vary response header of Middleware.
+// A custom Resolver which reads a request header calls it, so shared caches
+// don't serve a response in one language to other users.
+func Vary(r *http.Request, header string) {
+	if headers, ok := r.Context().Value(varyKey{}).(*[]string); ok {
+		for _, h := range *headers {
+			if h == header {
+				return
+			}
+		}
+
+		*headers = append(*headers, header)
+	}
+}
+
+// Context key of request headers the response varies by.
+type varyKey struct{}
+
+// Parse a language tag, an empty or wrong value is ignored.
+func parse(value string) []language.Tag {
+	if value == "" {
+		return nil
+	}
+
+	tag, err := language.Parse(value)
+	if err != nil {
+		return nil
+	}
+
+	return []language.Tag{tag}
+}
+
+// Middleware negotiates the request language and stores its printer in the
+// request context, see i18n.FromContext and i18n.LanguageFromContext.
+// Resolvers are tried in order until one of them resolves a loaded language,
+// otherwise the fallback language is used. The default order is the `lang`
+// query parameter, the `lang` cookie and the Accept-Language header.
+//
+// The Content-Language response header is set to the negotiated language,
+// the Vary header lists request headers read by resolvers, e.g.
+// Accept-Language and Cookie.
+func Middleware(t *i18n.I18n, resolvers ...Resolver) func(http.Handler) http.Handler {
+	if len(resolvers) == 0 {
+		resolvers = []Resolver{Query("lang"), Cookie("lang"), Header()}
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			tag, vary := resolve(t, r, resolvers)
+
+			if tag != language.Und {
+				w.Header().Set("Content-Language", tag.String())
+			}
+
+			for _, header := range vary {
+				w.Header().Add("Vary", header)
+			}
+
+			ctx := i18n.NewContext(r.Context(), t.Printer(tag))
+			ctx = i18n.NewLanguageContext(ctx, tag)
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// Resolve the request language and get request headers read by resolvers.
+// Every resolver is called, so the headers don't depend on the request.
+func resolve(t *i18n.I18n, r *http.Request, resolvers []Resolver) (language.Tag, []string) {
+	var vary []string
+
+	r = r.WithContext(context.WithValue(r.Context(), varyKey{}, &vary))
+
+	result, found := language.Und, false
+
+	for _, resolver := range resolvers {
+		tag, ok := t.Match(resolver(r)...)
+		if ok && !found {
+			result, found = tag, true
+		}
+	}
+
+	if !found {
+		result, _ = t.Match()
+	}
+
+	return result, vary
+}
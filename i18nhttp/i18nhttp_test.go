@@ -0,0 +1,165 @@
+package i18nhttp
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yarigo/i18n/v2"
+	"golang.org/x/text/language"
+)
+
+// Load translations for tests.
+func load(t *testing.T) *i18n.I18n {
+	path := filepath.Join(t.TempDir(), "bundle.yaml")
+	data := "http hello:\n  en: Hello\n  ru: Привет\n  de: Hallo\n"
+
+	assert.NoError(t, os.WriteFile(path, []byte(data), 0o600))
+
+	tr := i18n.New(&i18n.Config{Path: path, Fallback: language.English})
+	assert.NoError(t, tr.Load())
+
+	return tr
+}
+
+func Test_Middleware(t *testing.T) {
+	t.Parallel()
+
+	tr := load(t)
+
+	custom := func(r *http.Request) []language.Tag {
+		Vary(r, "X-Language")
+
+		return parse(r.Header.Get("X-Language"))
+	}
+
+	testCases := []struct {
+		name      string
+		resolvers []Resolver
+		request   func() *http.Request
+		language  string
+		vary      []string
+		out       string
+	}{
+		{
+			name: "query",
+			request: func() *http.Request {
+				return httptest.NewRequest(http.MethodGet, "/?lang=ru", nil)
+			},
+			language: "ru",
+			vary:     []string{"Cookie", "Accept-Language"},
+			out:      "Привет",
+		},
+		{
+			name: "cookie",
+			request: func() *http.Request {
+				r := httptest.NewRequest(http.MethodGet, "/", nil)
+				r.AddCookie(&http.Cookie{Name: "lang", Value: "de"})
+
+				return r
+			},
+			language: "de",
+			vary:     []string{"Cookie", "Accept-Language"},
+			out:      "Hallo",
+		},
+		{
+			name: "header",
+			request: func() *http.Request {
+				r := httptest.NewRequest(http.MethodGet, "/", nil)
+				r.Header.Set("Accept-Language", "fr-FR, ru-RU;q=0.8, en;q=0.5")
+
+				return r
+			},
+			language: "ru",
+			vary:     []string{"Cookie", "Accept-Language"},
+			out:      "Привет",
+		},
+		{
+			name: "query goes before header",
+			request: func() *http.Request {
+				r := httptest.NewRequest(http.MethodGet, "/?lang=de", nil)
+				r.Header.Set("Accept-Language", "ru")
+
+				return r
+			},
+			language: "de",
+			vary:     []string{"Cookie", "Accept-Language"},
+			out:      "Hallo",
+		},
+		{
+			name: "unknown language in query",
+			request: func() *http.Request {
+				r := httptest.NewRequest(http.MethodGet, "/?lang=fr", nil)
+				r.Header.Set("Accept-Language", "ru")
+
+				return r
+			},
+			language: "ru",
+			vary:     []string{"Cookie", "Accept-Language"},
+			out:      "Привет",
+		},
+		{
+			name: "fallback language",
+			request: func() *http.Request {
+				return httptest.NewRequest(http.MethodGet, "/?lang=-", nil)
+			},
+			language: "en",
+			vary:     []string{"Cookie", "Accept-Language"},
+			out:      "Hello",
+		},
+		{
+			name:      "path prefix",
+			resolvers: []Resolver{PathPrefix(), Header()},
+			request: func() *http.Request {
+				r := httptest.NewRequest(http.MethodGet, "/de/about", nil)
+				r.Header.Set("Accept-Language", "ru")
+
+				return r
+			},
+			language: "de",
+			vary:     []string{"Accept-Language"},
+			out:      "Hallo",
+		},
+		{
+			name:      "custom resolver",
+			resolvers: []Resolver{custom, Query("lang")},
+			request: func() *http.Request {
+				r := httptest.NewRequest(http.MethodGet, "/", nil)
+				r.Header.Set("X-Language", "ru")
+
+				return r
+			},
+			language: "ru",
+			vary:     []string{"X-Language"},
+			out:      "Привет",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name,
+			func(t *testing.T) {
+				handler := Middleware(tr, tc.resolvers...)(http.HandlerFunc(
+					func(w http.ResponseWriter, r *http.Request) {
+						tag, ok := i18n.LanguageFromContext(r.Context())
+						assert.True(t, ok)
+						assert.Equal(t, tc.language, tag.String())
+
+						_, err := w.Write([]byte(i18n.FromContext(r.Context()).Sprintf("http hello")))
+						assert.NoError(t, err)
+					},
+				))
+
+				w := httptest.NewRecorder()
+				handler.ServeHTTP(w, tc.request())
+
+				assert.Equal(t, tc.language, w.Header().Get("Content-Language"))
+				assert.Equal(t, tc.vary, w.Header().Values("Vary"))
+				assert.Equal(t, tc.out, w.Body.String())
+			},
+		)
+	}
+}
@@ -0,0 +1,150 @@
+package i18n
+
+import (
+	"sort"
+	"sync"
+
+	"golang.org/x/text/language"
+)
+
+// Index of loaded messages.
+type index struct {
+	mu       sync.RWMutex
+	messages map[language.Tag]map[string]translationMessage
+}
+
+// Create a new index.
+func newIndex() *index {
+	return &index{messages: make(map[language.Tag]map[string]translationMessage)}
+}
+
+// Add a message to the index. The message replaces a message with the same
+// id loaded before.
+func (i *index) add(tag language.Tag, m translationMessage) {
+	if i == nil {
+		return
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if _, ok := i.messages[tag]; !ok {
+		i.messages[tag] = make(map[string]translationMessage)
+	}
+
+	i.messages[tag][m.ID] = m
+}
+
+// Get all messages of the language sorted by id.
+func (i *index) list(tag language.Tag) []translationMessage {
+	if i == nil {
+		return nil
+	}
+
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	messages := i.messages[tag]
+	result := make([]translationMessage, 0, len(messages))
+	for _, m := range messages {
+		result = append(result, m)
+	}
+
+	sort.Slice(result, func(a, b int) bool { return result[a].ID < result[b].ID })
+
+	return result
+}
+
+// Get a message of the language.
+func (i *index) lookup(tag language.Tag, id string) (translationMessage, bool) {
+	if i == nil {
+		return translationMessage{}, false
+	}
+
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	m, ok := i.messages[tag][id]
+
+	return m, ok
+}
+
+// Get message ids of the language sorted.
+func (i *index) ids(tag language.Tag) []string {
+	if i == nil {
+		return nil
+	}
+
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	result := make([]string, 0, len(i.messages[tag]))
+	for id := range i.messages[tag] {
+		result = append(result, id)
+	}
+
+	sort.Strings(result)
+
+	return result
+}
+
+// Tags returns loaded languages sorted by tag.
+func (i *I18n) Tags() []language.Tag {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	tags := make([]language.Tag, 0, len(i.printer))
+	for tag := range i.printer {
+		tags = append(tags, tag)
+	}
+
+	sort.Slice(tags, func(a, b int) bool { return tags[a].String() < tags[b].String() })
+
+	return tags
+}
+
+// Has reports whether the message is loaded for the language.
+func (i *I18n) Has(tag language.Tag, id string) bool {
+	_, ok := i.index.lookup(tag, id)
+
+	return ok
+}
+
+// IDs returns sorted message ids loaded for the language.
+func (i *I18n) IDs(tag language.Tag) []string {
+	return i.index.ids(tag)
+}
+
+// Remove a message from the index.
+func (i *index) remove(tag language.Tag, id string) {
+	if i == nil {
+		return
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	delete(i.messages[tag], id)
+}
+
+// Create a copy of the index.
+func (i *index) clone() *index {
+	result := newIndex()
+
+	if i == nil {
+		return result
+	}
+
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	for tag, messages := range i.messages {
+		result.messages[tag] = make(map[string]translationMessage, len(messages))
+
+		for id, m := range messages {
+			result.messages[tag][id] = m
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,50 @@
+package i18n
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+)
+
+func Test_I18nIndex(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	files := map[string]string{
+		"en/main.json":  `[{"id": "index b", "message": "B"}, {"id": "index a", "message": "A"}]`,
+		"en/extra.yaml": "index c:\n  one: one C\n  other: '%d C'\n",
+		"ru/main.json":  `{"index a": "А"}`,
+		"de/.gitkeep":   "",
+		"fr/empty.json": `[]`,
+	}
+
+	for name, data := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+
+		assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		assert.NoError(t, os.WriteFile(path, []byte(data), 0o600))
+	}
+
+	i18n := New(&Config{Path: dir, Fallback: language.English})
+	assert.NoError(t, i18n.Load())
+
+	assert.Equal(
+		t,
+		[]language.Tag{language.German, language.English, language.French, language.Russian},
+		i18n.Tags(),
+	)
+
+	assert.Equal(t, []string{"index a", "index b", "index c"}, i18n.IDs(language.English))
+	assert.Equal(t, []string{"index a"}, i18n.IDs(language.Russian))
+	assert.Empty(t, i18n.IDs(language.German))
+	assert.Empty(t, i18n.IDs(language.Japanese))
+
+	assert.True(t, i18n.Has(language.English, "index c"))
+	assert.True(t, i18n.Has(language.Russian, "index a"))
+	assert.False(t, i18n.Has(language.Russian, "index b"))
+	assert.False(t, i18n.Has(language.Japanese, "index a"))
+}
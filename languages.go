@@ -0,0 +1,197 @@
+package i18n
+
+import (
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+	"golang.org/x/text/language/display"
+)
+
+// Language is a loaded language with metadata.
+type Language struct {
+	Tag language.Tag
+	// Text direction.
+	Direction Direction
+	// The most likely script of the language.
+	Script language.Script
+	// Language name in the language itself, e.g. `русский`, overridden by
+	// a message `language:<tag>` of the language.
+	Name string
+	// Cardinal plural categories of the language in CLDR order, e.g. `one`,
+	// `few`, `many`, `other`.
+	PluralCategories []string
+	// Built-in formatting data of the language.
+	Formatting Formatting
+}
+
+// Formatting reports which formatters have built-in CLDR data of a language.
+type Formatting struct {
+	// Dates, times and relative times.
+	Dates bool
+	// Symbol placement of Currency.
+	Currency bool
+	// Units of Compact.
+	Compact bool
+	// List patterns of List and ListOf.
+	Lists bool
+	// Unit names of Measure, ByteSize and Duration.
+	Units bool
+}
+
+//go:generate go run gen.go
+
+// FormattingOf returns built-in formatting data of the language, its parent
+// or base language. Formatters use the same lookup, a language without data
+// is formatted with English data and the currency symbol goes before the
+// amount.
+func FormattingOf(tag language.Tag) Formatting {
+	_, dates := localeKey(tag, func(key string) bool {
+		_, ok := calendars[key]
+		return ok
+	})
+
+	_, currency := localeKey(tag, func(key string) bool {
+		_, ok := currencyPatterns[key]
+		return ok
+	})
+
+	_, compact := localeKey(tag, func(key string) bool {
+		_, ok := compactUnits[key]
+		return ok
+	})
+
+	_, lists := localeKey(tag, func(key string) bool {
+		_, ok := lists[key]
+		return ok
+	})
+
+	_, units := localeKey(tag, func(key string) bool {
+		_, ok := units[key]
+		return ok
+	})
+
+	return Formatting{Dates: dates, Currency: currency, Compact: compact, Lists: lists, Units: units}
+}
+
+// Get a key of locale data of the language, its parent or base language.
+// The base language is used only if it's written in the same script, e.g.
+// `zh-TW` doesn't use `zh` data, which is Simplified Chinese.
+func localeKey(tag language.Tag, has func(key string) bool) (string, bool) {
+	for t := tag; t != language.Und; t = t.Parent() {
+		if has(t.String()) {
+			return t.String(), true
+		}
+	}
+
+	base, _ := tag.Base()
+	script, _ := tag.Script()
+
+	if baseScript, _ := language.Make(base.String()).Script(); baseScript == script && has(base.String()) {
+		return base.String(), true
+	}
+
+	return "", false
+}
+
+// Languages returns loaded languages with metadata sorted by tag.
+func (i *I18n) Languages() []Language {
+	tags := i.Tags()
+	result := make([]Language, 0, len(tags))
+
+	for _, tag := range tags {
+		script, _ := tag.Script()
+
+		name, ok := i.displayOverride(tag, languageNamePrefix+tag.String())
+		if !ok {
+			name = display.Self.Name(tag)
+		}
+
+		result = append(result, Language{
+			Tag:              tag,
+			Direction:        direction(tag),
+			Script:           script,
+			Name:             name,
+			PluralCategories: pluralCategories(tag),
+			Formatting:       FormattingOf(tag),
+		})
+	}
+
+	return result
+}
+
+// Plural categories in CLDR order.
+var pluralForms = []struct {
+	form plural.Form
+	name string
+}{
+	{form: plural.Zero, name: "zero"},
+	{form: plural.One, name: "one"},
+	{form: plural.Two, name: "two"},
+	{form: plural.Few, name: "few"},
+	{form: plural.Many, name: "many"},
+	{form: plural.Other, name: "other"},
+}
+
+// Get cardinal plural categories of the language. Categories are detected
+// by matching integers and decimals with one and two fraction digits.
+func pluralCategories(tag language.Tag) []string {
+	forms := make(map[plural.Form]bool)
+
+	for n := 0; n < 1000; n++ {
+		forms[plural.Cardinal.MatchPlural(tag, n, 0, 0, 0, 0)] = true
+
+		// Decimals n/10 and n/100.
+		for v, div := 1, 10; v <= 2; v, div = v+1, div*10 {
+			w, t := fraction(n%div, v)
+			forms[plural.Cardinal.MatchPlural(tag, n/div, v, w, n%div, t)] = true
+		}
+	}
+
+	result := make([]string, 0, len(forms))
+
+	for _, form := range pluralForms {
+		if forms[form.form] {
+			result = append(result, form.name)
+		}
+	}
+
+	return result
+}
+
+// Get the cardinal plural category of a number with integer digits i and v
+// fraction digits f.
+func pluralCategory(tag language.Tag, i, v, f int) string {
+	w, t := fraction(f, v)
+	form := plural.Cardinal.MatchPlural(tag, i, v, w, f, t)
+
+	for _, f := range pluralForms {
+		if f.form == form {
+			return f.name
+		}
+	}
+
+	return "other"
+}
+
+// Get the text of the plural category, the text of `other` if missing.
+func pluralForm(forms map[string]string, category string) string {
+	if text, ok := forms[category]; ok {
+		return text
+	}
+
+	return forms["other"]
+}
+
+// Get the number of fraction digits and fraction digits without trailing
+// zeros, from v fraction digits f.
+func fraction(f, v int) (w, t int) {
+	for f != 0 && f%10 == 0 {
+		f /= 10
+		v--
+	}
+
+	if f == 0 {
+		return 0, 0
+	}
+
+	return v, f
+}
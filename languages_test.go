@@ -0,0 +1,95 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+func Test_I18nLanguages(t *testing.T) {
+	t.Parallel()
+
+	i18n := New(&Config{})
+
+	for _, tag := range []language.Tag{language.Russian, language.English, language.Arabic} {
+		i18n.printer[tag] = message.NewPrinter(tag)
+	}
+
+	assert.Equal(t, []Language{
+		{
+			Tag:              language.Arabic,
+			Direction:        RTL,
+			Script:           language.MustParseScript("Arab"),
+			Name:             "العربية",
+			PluralCategories: []string{"zero", "one", "two", "few", "many", "other"},
+			Formatting:       Formatting{Dates: true, Currency: true, Compact: true, Lists: true, Units: true},
+		},
+		{
+			Tag:              language.English,
+			Direction:        LTR,
+			Script:           language.MustParseScript("Latn"),
+			Name:             "English",
+			PluralCategories: []string{"one", "other"},
+			Formatting:       Formatting{Dates: true, Currency: true, Compact: true, Lists: true, Units: true},
+		},
+		{
+			Tag:              language.Russian,
+			Direction:        LTR,
+			Script:           language.MustParseScript("Cyrl"),
+			Name:             "русский",
+			PluralCategories: []string{"one", "few", "many", "other"},
+			Formatting:       Formatting{Dates: true, Currency: true, Compact: true, Lists: true, Units: true},
+		},
+	}, i18n.Languages())
+}
+
+func Test_FormattingOf(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		tag string
+		out Formatting
+	}{
+		{tag: "en", out: Formatting{Dates: true, Currency: true, Compact: true, Lists: true, Units: true}},
+		{tag: "en-GB", out: Formatting{Dates: true, Currency: true, Compact: true, Lists: true, Units: true}},
+		{tag: "pt-BR", out: Formatting{Dates: true, Currency: true, Compact: true, Lists: true, Units: true}},
+		{tag: "nl", out: Formatting{Dates: true, Currency: true, Lists: true, Units: true}},
+		{tag: "zh-TW", out: Formatting{Dates: true, Currency: true, Compact: true, Lists: true, Units: true}},
+		{tag: "zh-Hans-TW", out: Formatting{Dates: true, Currency: true, Compact: true, Lists: true, Units: true}},
+		{tag: "ru-Latn", out: Formatting{}},
+		{tag: "sw", out: Formatting{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.tag,
+			func(t *testing.T) {
+				assert.Equal(t, tc.out, FormattingOf(language.MustParse(tc.tag)))
+			},
+		)
+	}
+}
+
+func Test_PluralCategories(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		tag        string
+		categories []string
+	}{
+		{tag: "ja", categories: []string{"other"}},
+		{tag: "fr", categories: []string{"one", "other"}},
+		{tag: "pl", categories: []string{"one", "few", "many", "other"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.tag,
+			func(t *testing.T) {
+				assert.Equal(t, tc.categories, pluralCategories(language.MustParse(tc.tag)))
+			},
+		)
+	}
+}
@@ -0,0 +1,116 @@
+package i18n
+
+import (
+	"io/fs"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+// Layout gets a language tag of a translation file from its slash separated
+// path relative to the languages folder. A file without a tag is skipped.
+// Several files may have the same tag.
+type Layout func(path string) (language.Tag, bool)
+
+// LayoutDir takes the tag from the first path element: a folder per language
+// `en/main.json` or a file `en.json`. Files of formats with the tag in the
+// file name, e.g. `messages_en.properties`, get the tag from the name.
+func LayoutDir(p string) (language.Tag, bool) {
+	first, _, found := strings.Cut(p, "/")
+	if !found {
+		return fileTag(first)
+	}
+
+	tag, err := language.Parse(first)
+
+	return tag, err == nil
+}
+
+// LayoutFile takes the tag from the file name without extension, e.g.
+// `en.json` or `app/en.yaml`.
+func LayoutFile(p string) (language.Tag, bool) {
+	return fileTag(path.Base(p))
+}
+
+// LayoutName takes the tag from the file name part before the extension,
+// e.g. `messages.en.json` or `app/errors.pt-BR.yaml`.
+func LayoutName(p string) (language.Tag, bool) {
+	name := path.Base(p)
+	name = strings.TrimSuffix(name, path.Ext(name))
+
+	ext := path.Ext(name)
+	if ext == "" {
+		return language.Und, false
+	}
+
+	tag, err := language.Parse(ext[1:])
+
+	return tag, err == nil
+}
+
+// Get a tag of the file name without extension, or a tag of the file name
+// provided by the decoder.
+func fileTag(name string) (language.Tag, bool) {
+	if d, ok := decoderFor(filepath.Ext(name)); ok {
+		if d, ok := d.(namedDecoder); ok {
+			return d.tagFromName(name)
+		}
+	}
+
+	tag, err := language.Parse(strings.TrimSuffix(name, filepath.Ext(name)))
+
+	return tag, err == nil
+}
+
+// Find translation files of the languages folder with Config.Layout and
+// group them by language tag.
+func (i *I18n) layoutTags() error {
+	root := i.config.Path
+	if i.config.FS != nil {
+		root = fsPath(root)
+	}
+
+	err := i.walkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || path == root {
+			return err
+		}
+
+		if i.skip(path, entry) {
+			if entry.IsDir() {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if entry.IsDir() {
+			return nil
+		}
+
+		tag, ok := i.config.Layout(i.relative(path))
+		if !ok {
+			i.debugf("skip without language tag %v", path)
+			return nil
+		}
+
+		n, ok := contains(i.languages, tag)
+		if !ok {
+			i.languages = append(i.languages, lang{tag: tag})
+			i.printer[tag] = message.NewPrinter(tag)
+
+			n = len(i.languages) - 1
+		}
+
+		i.languages[n].files = append(i.languages[n].files, path)
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return i.fallback()
+}
@@ -0,0 +1,161 @@
+package i18n
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+)
+
+func Test_Layouts(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name   string
+		layout Layout
+		path   string
+		tag    language.Tag
+		ok     bool
+	}{
+		{name: "dir", layout: LayoutDir, path: "en/main.json", tag: language.English, ok: true},
+		{name: "dir file", layout: LayoutDir, path: "en.json", tag: language.English, ok: true},
+		{name: "dir suffix", layout: LayoutDir, path: "messages_ru.properties", tag: language.Russian, ok: true},
+		{name: "dir wrong", layout: LayoutDir, path: "common/main.json"},
+		{name: "file", layout: LayoutFile, path: "app/pt-BR.yaml", tag: language.BrazilianPortuguese, ok: true},
+		{name: "file wrong", layout: LayoutFile, path: "en/main.json"},
+		{name: "name", layout: LayoutName, path: "app/messages.ru.json", tag: language.Russian, ok: true},
+		{name: "name without tag", layout: LayoutName, path: "messages.json"},
+		{name: "name wrong", layout: LayoutName, path: "messages.main.json"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name,
+			func(t *testing.T) {
+				tag, ok := tc.layout(tc.path)
+
+				assert.Equal(t, tc.ok, ok)
+
+				if tc.ok {
+					assert.Equal(t, tc.tag, tag)
+				}
+			},
+		)
+	}
+}
+
+func Test_I18nLoadLayout(t *testing.T) {
+	t.Parallel()
+
+	custom := func(path string) (language.Tag, bool) {
+		if !strings.HasPrefix(path, "locales/") {
+			return language.Und, false
+		}
+
+		return LayoutFile(path)
+	}
+
+	testCases := []struct {
+		name   string
+		layout Layout
+		files  map[string]string
+		// Expected translations of `layout hello` and `layout bye`.
+		out map[language.Tag][]string
+		err bool
+	}{
+		{
+			name:   "default",
+			layout: nil,
+			files: map[string]string{
+				"en.json":      `{"layout hello": "Hello", "layout bye": "Bye"}`,
+				"ru/main.json": `{"layout hello": "Привет"}`,
+				"ru/bye.yaml":  `layout bye: Пока`,
+			},
+			out: map[language.Tag][]string{
+				language.English: {"Hello", "Bye"},
+				language.Russian: {"Привет", "Пока"},
+			},
+		},
+		{
+			name:   "default with wrong name",
+			layout: nil,
+			files:  map[string]string{"common.json": `{}`},
+			err:    true,
+		},
+		{
+			name:   "file",
+			layout: LayoutFile,
+			files: map[string]string{
+				"app/en.json":    `{"layout hello": "Hello"}`,
+				"errors/en.json": `{"layout bye": "Bye"}`,
+				"app/ru.json":    `{"layout hello": "Привет", "layout bye": "Пока"}`,
+				"common.json":    `{"layout hello": "Skipped"}`,
+			},
+			out: map[language.Tag][]string{
+				language.English: {"Hello", "Bye"},
+				language.Russian: {"Привет", "Пока"},
+			},
+		},
+		{
+			name:   "name",
+			layout: LayoutName,
+			files: map[string]string{
+				"messages.en.json": `{"layout hello": "Hello"}`,
+				"errors.en.yaml":   `layout bye: Bye`,
+				"messages.ru.json": `{"layout hello": "Привет", "layout bye": "Пока"}`,
+			},
+			out: map[language.Tag][]string{
+				language.English: {"Hello", "Bye"},
+				language.Russian: {"Привет", "Пока"},
+			},
+		},
+		{
+			name:   "custom",
+			layout: custom,
+			files: map[string]string{
+				"locales/en.json": `{"layout hello": "Hello", "layout bye": "Bye"}`,
+				"other/ru.json":   `{"layout hello": "Skipped"}`,
+			},
+			out: map[language.Tag][]string{
+				language.English: {"Hello", "Bye"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name,
+			func(t *testing.T) {
+				dir := t.TempDir()
+
+				for name, data := range tc.files {
+					path := filepath.Join(dir, filepath.FromSlash(name))
+
+					assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+					assert.NoError(t, os.WriteFile(path, []byte(data), 0o600))
+				}
+
+				i18n := New(&Config{Path: dir, Layout: tc.layout})
+				err := i18n.Load()
+
+				if tc.err {
+					assert.Error(t, err)
+					return
+				}
+
+				assert.NoError(t, err)
+				assert.Len(t, i18n.Tags(), len(tc.out))
+
+				for tag, out := range tc.out {
+					assert.Equal(t, []string{"layout bye", "layout hello"}, i18n.IDs(tag))
+
+					p := i18n.Printer(tag)
+					assert.Equal(t, out, []string{p.Sprintf("layout hello"), p.Sprintf("layout bye")})
+				}
+			},
+		)
+	}
+}
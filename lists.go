@@ -0,0 +1,140 @@
+package i18n
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// ListKind is a kind of a formatted list.
+type ListKind int
+
+// List kinds of CLDR.
+const (
+	// Conjunction is a list of all items, e.g. `A, B, and C`.
+	Conjunction ListKind = iota
+	// Disjunction is a list of alternatives, e.g. `A, B, or C`.
+	Disjunction
+	// Unit is a list of measures, e.g. `3 feet, 7 inches`.
+	Unit
+)
+
+// List joins items with the list patterns of the language, see
+// FormattingOf.
+func List(tag language.Tag, items []string, kind ListKind) string {
+	return listData(tag, kind).join(items)
+}
+
+// ListOf formats a slice as a list in the printer language when passed as
+// an argument of a printer. The verb of the argument formats every item:
+//
+//	p.Sprintf("Invited %v", i18n.ListOf(names, i18n.Conjunction)) // Invited Ann, Bob, and Eve
+//	p.Sprintf("Sizes: %d", i18n.ListOf(sizes, i18n.Disjunction))  // Sizes: 1,000 or 2,000
+func ListOf(items interface{}, kind ListKind) fmt.Formatter {
+	return list{items: items, kind: kind}
+}
+
+// Slice formatted as a list.
+type list struct {
+	items interface{}
+	kind  ListKind
+}
+
+// Format items with the verb and flags of the argument.
+func (l list) Format(s fmt.State, verb rune) {
+	value := reflect.ValueOf(l.items)
+	if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+		fmt.Fprintf(s, "%%!%c(%T=%v)", verb, l.items, l.items)
+		return
+	}
+
+	tag := stateLanguage(s)
+	p := numberPrinter(tag)
+	format := itemFormat(s, verb)
+
+	items := make([]string, value.Len())
+	for n := range items {
+		items[n] = p.Sprintf(format, value.Index(n).Interface())
+	}
+
+	fmt.Fprint(s, listData(tag, l.kind).join(items))
+}
+
+// Get the format of an item from the state of a list argument.
+func itemFormat(s fmt.State, verb rune) string {
+	var b strings.Builder
+
+	b.WriteByte('%')
+
+	for _, flag := range "+-# 0" {
+		if s.Flag(int(flag)) {
+			b.WriteRune(flag)
+		}
+	}
+
+	if width, ok := s.Width(); ok {
+		fmt.Fprint(&b, width)
+	}
+
+	if precision, ok := s.Precision(); ok {
+		fmt.Fprintf(&b, ".%v", precision)
+	}
+
+	b.WriteRune(verb)
+
+	return b.String()
+}
+
+// List patterns of CLDR, `{0}` and `{1}` are joined parts.
+type listPatterns struct {
+	start  string
+	middle string
+	end    string
+	two    string
+}
+
+// Join items: two items with the pattern for two, more items with start,
+// middle and end patterns.
+func (l listPatterns) join(items []string) string {
+	switch len(items) {
+	case 0:
+		return ""
+	case 1:
+		return items[0]
+	case 2:
+		return joinPattern(l.two, items[0], items[1])
+	}
+
+	result := joinPattern(l.end, items[len(items)-2], items[len(items)-1])
+
+	for n := len(items) - 3; n > 0; n-- {
+		result = joinPattern(l.middle, items[n], result)
+	}
+
+	return joinPattern(l.start, items[0], result)
+}
+
+// Replace `{0}` and `{1}` of the pattern.
+func joinPattern(pattern, first, second string) string {
+	return strings.NewReplacer("{0}", first, "{1}", second).Replace(pattern)
+}
+
+// Get list patterns of the kind for the language, see FormattingOf. An
+// unknown kind is a conjunction.
+func listData(tag language.Tag, kind ListKind) listPatterns {
+	if kind < Conjunction || kind > Unit {
+		kind = Conjunction
+	}
+
+	key, ok := localeKey(tag, func(key string) bool {
+		_, ok := lists[key]
+		return ok
+	})
+	if !ok {
+		key = "en"
+	}
+
+	return lists[key][kind]
+}
@@ -0,0 +1,90 @@
+package i18n
+
+// List patterns separated with commas, except the end and two items.
+func commaList(end, two string) listPatterns {
+	return listPatterns{start: "{0}, {1}", middle: "{0}, {1}", end: end, two: two}
+}
+
+// List patterns of the same pattern.
+func plainList(pattern string) listPatterns {
+	return listPatterns{start: pattern, middle: pattern, end: pattern, two: pattern}
+}
+
+// List patterns of CLDR by language and list kind.
+var lists = map[string][3]listPatterns{
+	"en": {
+		commaList("{0}, and {1}", "{0} and {1}"),
+		commaList("{0}, or {1}", "{0} or {1}"),
+		plainList("{0}, {1}"),
+	},
+	"de": {
+		commaList("{0} und {1}", "{0} und {1}"),
+		commaList("{0} oder {1}", "{0} oder {1}"),
+		commaList("{0} und {1}", "{0}, {1}"),
+	},
+	"es": {
+		commaList("{0} y {1}", "{0} y {1}"),
+		commaList("{0} o {1}", "{0} o {1}"),
+		commaList("{0} y {1}", "{0} y {1}"),
+	},
+	"fr": {
+		commaList("{0} et {1}", "{0} et {1}"),
+		commaList("{0} ou {1}", "{0} ou {1}"),
+		commaList("{0} et {1}", "{0} et {1}"),
+	},
+	"it": {
+		commaList("{0} e {1}", "{0} e {1}"),
+		commaList("{0} o {1}", "{0} o {1}"),
+		commaList("{0} e {1}", "{0} e {1}"),
+	},
+	"ja": {
+		plainList("{0}、{1}"),
+		{start: "{0}、{1}", middle: "{0}、{1}", end: "{0}、または{1}", two: "{0}または{1}"},
+		plainList("{0} {1}"),
+	},
+	"ko": {
+		commaList("{0} 및 {1}", "{0} 및 {1}"),
+		commaList("{0} 또는 {1}", "{0} 또는 {1}"),
+		plainList("{0} {1}"),
+	},
+	"nl": {
+		commaList("{0} en {1}", "{0} en {1}"),
+		commaList("{0} of {1}", "{0} of {1}"),
+		commaList("{0} en {1}", "{0}, {1}"),
+	},
+	"pl": {
+		commaList("{0} i {1}", "{0} i {1}"),
+		commaList("{0} lub {1}", "{0} lub {1}"),
+		commaList("{0} i {1}", "{0} i {1}"),
+	},
+	"pt": {
+		commaList("{0} e {1}", "{0} e {1}"),
+		commaList("{0} ou {1}", "{0} ou {1}"),
+		commaList("{0} e {1}", "{0} e {1}"),
+	},
+	"ru": {
+		commaList("{0} и {1}", "{0} и {1}"),
+		commaList("{0} или {1}", "{0} или {1}"),
+		plainList("{0} {1}"),
+	},
+	"uk": {
+		commaList("{0} і {1}", "{0} і {1}"),
+		commaList("{0} або {1}", "{0} або {1}"),
+		commaList("{0} і {1}", "{0} і {1}"),
+	},
+	"zh": {
+		{start: "{0}、{1}", middle: "{0}、{1}", end: "{0}和{1}", two: "{0}和{1}"},
+		{start: "{0}、{1}", middle: "{0}、{1}", end: "{0}或{1}", two: "{0}或{1}"},
+		plainList("{0}{1}"),
+	},
+	"zh-Hant": {
+		{start: "{0}、{1}", middle: "{0}、{1}", end: "{0}和{1}", two: "{0}和{1}"},
+		{start: "{0}、{1}", middle: "{0}、{1}", end: "{0}或{1}", two: "{0}或{1}"},
+		plainList("{0} {1}"),
+	},
+	"ar": {
+		plainList("{0} و{1}"),
+		plainList("{0} أو {1}"),
+		plainList("{0} و{1}"),
+	},
+}
@@ -0,0 +1,55 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+func Test_List(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name  string
+		tag   language.Tag
+		items []string
+		kind  ListKind
+		out   string
+	}{
+		{name: "empty", tag: language.English, out: ""},
+		{name: "one", tag: language.English, items: []string{"A"}, out: "A"},
+		{name: "two", tag: language.English, items: []string{"A", "B"}, out: "A and B"},
+		{name: "three", tag: language.English, items: []string{"A", "B", "C"}, out: "A, B, and C"},
+		{name: "four", tag: language.English, items: []string{"A", "B", "C", "D"}, out: "A, B, C, and D"},
+		{name: "disjunction", tag: language.English, items: []string{"A", "B", "C"}, kind: Disjunction, out: "A, B, or C"},
+		{name: "unit", tag: language.English, items: []string{"3 feet", "7 inches"}, kind: Unit, out: "3 feet, 7 inches"},
+		{name: "de", tag: language.German, items: []string{"A", "B", "C"}, out: "A, B und C"},
+		{name: "ru", tag: language.Russian, items: []string{"A", "B", "C"}, kind: Disjunction, out: "A, B или C"},
+		{name: "zh", tag: language.Chinese, items: []string{"A", "B", "C"}, out: "A、B和C"},
+		{name: "language without patterns", tag: language.Swahili, items: []string{"A", "B"}, out: "A and B"},
+		{name: "unknown kind", tag: language.English, items: []string{"A", "B"}, kind: 10, out: "A and B"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name,
+			func(t *testing.T) {
+				assert.Equal(t, tc.out, List(tc.tag, tc.items, tc.kind))
+			},
+		)
+	}
+}
+
+func Test_ListOf(t *testing.T) {
+	t.Parallel()
+
+	en := message.NewPrinter(language.English)
+	de := message.NewPrinter(language.German)
+
+	assert.Equal(t, "Invited Ann, Bob, and Eve", en.Sprintf("Invited %v", ListOf([]string{"Ann", "Bob", "Eve"}, Conjunction)))
+	assert.Equal(t, "1.000 oder 2.000", de.Sprintf("%d", ListOf([]int{1000, 2000}, Disjunction)))
+	assert.Equal(t, "1.50 and 2.25", en.Sprintf("%.2f", ListOf([]float64{1.5, 2.25}, Conjunction)))
+	assert.Equal(t, "%!v(int=1)", en.Sprint(ListOf(1, Conjunction)))
+}
@@ -0,0 +1,114 @@
+package i18n
+
+import (
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+// Get a printer of the fallback language of the instance. Values created
+// without an instance are rendered without translations.
+func fallbackPrinter(i *I18n) *message.Printer {
+	if i == nil {
+		return message.NewPrinter(language.Und)
+	}
+
+	return i.Printer(i.config.Fallback)
+}
+
+// Value rendered with a language-specific printer.
+type localizable interface {
+	localize(p *message.Printer) string
+}
+
+// Localize arguments which are localizable values.
+func localizeArgs(p *message.Printer, args []interface{}) []interface{} {
+	result := make([]interface{}, len(args))
+
+	for n, arg := range args {
+		if l, ok := arg.(localizable); ok {
+			result[n] = l.localize(p)
+		} else {
+			result[n] = arg
+		}
+	}
+
+	return result
+}
+
+// Error is an error with a localizable message. The message is translated
+// with a printer at the API boundary, e.g.:
+//
+//	var e *i18n.Error
+//	if errors.As(err, &e) {
+//		msg = e.Localize(t.Printer(tag))
+//	}
+//
+// Only errors created with I18n.NewError or I18n.WrapError render Error in
+// the fallback language. Errors created with the package-level NewError or
+// WrapError have no instance: Error renders the raw message id formatted
+// with the arguments, e.g. `user %v not found` as `user ivan not found`.
+type Error struct {
+	// Message id.
+	ID string
+	// Message arguments, localizable arguments are localized too.
+	Args []interface{}
+	// Wrapped error.
+	Err error
+
+	i18n *I18n
+}
+
+// NewError creates an error with a localizable message. Its Error method
+// renders the raw message id, not a translation: use I18n.NewError to
+// render it in the fallback language.
+func NewError(id string, args ...interface{}) *Error {
+	return &Error{ID: id, Args: args}
+}
+
+// WrapError creates an error with a localizable message wrapping the error.
+// Its Error method renders the raw message id, not a translation: use
+// I18n.WrapError to render it in the fallback language.
+func WrapError(err error, id string, args ...interface{}) *Error {
+	return &Error{ID: id, Args: args, Err: err}
+}
+
+// NewError creates an error with a localizable message rendered by Error in
+// the fallback language of the instance.
+func (i *I18n) NewError(id string, args ...interface{}) *Error {
+	return &Error{ID: id, Args: args, i18n: i}
+}
+
+// WrapError creates an error with a localizable message wrapping the error,
+// the message is rendered by Error in the fallback language of the instance.
+func (i *I18n) WrapError(err error, id string, args ...interface{}) *Error {
+	return &Error{ID: id, Args: args, Err: err, i18n: i}
+}
+
+// Error message in the fallback language of the instance which created the
+// error, or the raw message id without an instance, followed by the wrapped
+// error.
+func (i *Error) Error() string {
+	s := i.Localize(fallbackPrinter(i.i18n))
+
+	if i.Err != nil {
+		s += ": " + i.Err.Error()
+	}
+
+	return s
+}
+
+// Localize returns the error message translated by the printer. The wrapped
+// error is not included.
+func (i *Error) Localize(p *message.Printer) string {
+	return i.localize(p)
+}
+
+// Translate the error message.
+func (i *Error) localize(p *message.Printer) string {
+	return p.Sprintf(i.ID, localizeArgs(p, i.Args)...)
+}
+
+// Unwrap returns the wrapped error.
+func (i *Error) Unwrap() error {
+	return i.Err
+}
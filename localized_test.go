@@ -0,0 +1,78 @@
+package i18n
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+func Test_Error(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, message.SetString(language.English, "error not found %v", "%v not found"))
+	assert.NoError(t, message.SetString(language.Russian, "error not found %v", "%v не найден"))
+	assert.NoError(t, message.SetString(language.English, "error user", "User"))
+	assert.NoError(t, message.SetString(language.Russian, "error user", "Пользователь"))
+
+	i18n := New(&Config{Fallback: language.English})
+	i18n.printer[language.English] = message.NewPrinter(language.English)
+	i18n.printer[language.Russian] = message.NewPrinter(language.Russian)
+
+	testCases := []struct {
+		name     string
+		err      *Error
+		error    string
+		localize string
+	}{
+		{
+			name:     "message",
+			err:      i18n.NewError("error not found %v", "file"),
+			error:    "file not found",
+			localize: "file не найден",
+		},
+		{
+			name:     "localizable argument",
+			err:      i18n.NewError("error not found %v", i18n.NewError("error user")),
+			error:    "User not found",
+			localize: "Пользователь не найден",
+		},
+		{
+			name:     "wrapped error",
+			err:      i18n.WrapError(io.EOF, "error not found %v", "file"),
+			error:    "file not found: EOF",
+			localize: "file не найден",
+		},
+		{
+			name:     "without instance",
+			err:      WrapError(io.EOF, "error not found %v", NewError("error user")),
+			error:    "error not found error user: EOF",
+			localize: "Пользователь не найден",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name,
+			func(t *testing.T) {
+				assert.Equal(t, tc.error, tc.err.Error())
+				assert.Equal(t, tc.localize, tc.err.Localize(message.NewPrinter(language.Russian)))
+			},
+		)
+	}
+}
+
+func Test_ErrorUnwrap(t *testing.T) {
+	t.Parallel()
+
+	var err error = WrapError(io.EOF, "error read")
+
+	var e *Error
+
+	assert.True(t, errors.Is(err, io.EOF))
+	assert.True(t, errors.As(err, &e))
+	assert.Equal(t, "error read", e.ID)
+}
@@ -0,0 +1,37 @@
+package i18n
+
+import (
+	"golang.org/x/text/language"
+)
+
+// Match returns the best loaded language for the preferred languages. If no
+// language matches, the fallback language and false are returned.
+func (i *I18n) Match(preferred ...language.Tag) (language.Tag, bool) {
+	tags := i.supported()
+	if len(tags) == 0 || len(preferred) == 0 {
+		return i.config.Fallback, false
+	}
+
+	_, index, confidence := language.NewMatcher(tags).Match(preferred...)
+	if confidence == language.No {
+		return i.config.Fallback, false
+	}
+
+	return tags[index], true
+}
+
+// Get loaded languages, the fallback language goes first.
+func (i *I18n) supported() []language.Tag {
+	loaded := i.Tags()
+	tags := make([]language.Tag, 0, len(loaded))
+
+	for _, tag := range loaded {
+		if tag == i.config.Fallback {
+			tags = append([]language.Tag{tag}, tags...)
+		} else {
+			tags = append(tags, tag)
+		}
+	}
+
+	return tags
+}
@@ -0,0 +1,66 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+func Test_I18nMatch(t *testing.T) {
+	t.Parallel()
+
+	i18n := New(&Config{Fallback: language.English})
+
+	for _, tag := range []language.Tag{language.English, language.Russian, language.German} {
+		i18n.printer[tag] = message.NewPrinter(tag)
+	}
+
+	testCases := []struct {
+		name      string
+		preferred []language.Tag
+		tag       language.Tag
+		ok        bool
+	}{
+		{
+			name:      "exact language",
+			preferred: []language.Tag{language.Russian},
+			tag:       language.Russian,
+			ok:        true,
+		},
+		{
+			name:      "regional language",
+			preferred: []language.Tag{language.MustParse("de-AT")},
+			tag:       language.German,
+			ok:        true,
+		},
+		{
+			name:      "first matched language",
+			preferred: []language.Tag{language.French, language.Russian},
+			tag:       language.Russian,
+			ok:        true,
+		},
+		{
+			name:      "unknown language",
+			preferred: []language.Tag{language.French},
+			tag:       language.English,
+		},
+		{
+			name: "no preferred languages",
+			tag:  language.English,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name,
+			func(t *testing.T) {
+				tag, ok := i18n.Match(tc.preferred...)
+
+				assert.Equal(t, tc.tag, tag)
+				assert.Equal(t, tc.ok, ok)
+			},
+		)
+	}
+}
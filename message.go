@@ -0,0 +1,55 @@
+package i18n
+
+import (
+	"golang.org/x/text/language"
+)
+
+// Message is a loaded translation message with translator metadata.
+type Message struct {
+	ID string
+	// Message text, empty if the message has plural rules.
+	Message string
+	// Plural rules, nil if the message has a text.
+	Rules map[string]interface{}
+	// Description of the message for translators.
+	Description string
+	// Context where the message is used.
+	Context string
+	// Maximum length of the message in characters, 0 if not limited.
+	MaxLength int
+	// Hash of the source message the translation is made from.
+	Hash string
+	// Translation domain of the message, empty if the message has no domain.
+	Domain string
+}
+
+// Message returns a loaded message of the language.
+func (i *I18n) Message(tag language.Tag, id string) (Message, bool) {
+	m, ok := i.index.lookup(tag, id)
+	if !ok {
+		return Message{}, false
+	}
+
+	return newMessage(m), true
+}
+
+// Create a public message from the translation message.
+func newMessage(m translationMessage) Message {
+	result := Message{
+		ID:          m.ID,
+		Description: m.Description,
+		Context:     m.Context,
+		MaxLength:   m.MaxLength,
+		Hash:        m.Hash,
+	}
+
+	if m.Message != nil {
+		result.Message = *m.Message
+	}
+
+	if rules, ok := m.Rules.(map[string]interface{}); ok {
+		result.Rules = rules
+	}
+
+	return result
+}
@@ -0,0 +1,82 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+)
+
+func Test_I18nMessage(t *testing.T) {
+	t.Parallel()
+
+	i18n := New(&Config{})
+
+	tr := &translation{tag: language.English, index: i18n.index}
+	assert.NoError(t, tr.append([]byte(`[
+    {
+      "id": "metadata login",
+      "message": "Log in",
+      "description": "Login button",
+      "context": "header",
+      "maxLength": 10,
+      "hash": "a1b2"
+    },
+    {"id": "metadata apples", "rules": {"one": "%d apple", "other": "%d apples"}}
+  ]`)))
+
+	testCases := []struct {
+		name    string
+		tag     language.Tag
+		id      string
+		message Message
+		ok      bool
+	}{
+		{
+			name: "message with metadata",
+			tag:  language.English,
+			id:   "metadata login",
+			message: Message{
+				ID:          "metadata login",
+				Message:     "Log in",
+				Description: "Login button",
+				Context:     "header",
+				MaxLength:   10,
+				Hash:        "a1b2",
+			},
+			ok: true,
+		},
+		{
+			name: "message with rules",
+			tag:  language.English,
+			id:   "metadata apples",
+			message: Message{
+				ID:    "metadata apples",
+				Rules: map[string]interface{}{"one": "%d apple", "other": "%d apples"},
+			},
+			ok: true,
+		},
+		{
+			name: "message doesn't exists",
+			tag:  language.English,
+			id:   "metadata unknown",
+		},
+		{
+			name: "language doesn't exists",
+			tag:  language.German,
+			id:   "metadata login",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name,
+			func(t *testing.T) {
+				message, ok := i18n.Message(tc.tag, tc.id)
+
+				assert.Equal(t, tc.ok, ok)
+				assert.Equal(t, tc.message, message)
+			},
+		)
+	}
+}
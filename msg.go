@@ -0,0 +1,107 @@
+package i18n
+
+import (
+	"context"
+	"encoding/json"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+// Msg is a localizable message rendered later, when the language is known.
+// Libraries return it instead of a string without knowing the request
+// language.
+type Msg struct {
+	// Message id.
+	ID string
+	// Message arguments, localizable arguments are localized too.
+	Args []interface{}
+
+	printer *message.Printer
+	i18n    *I18n
+}
+
+// NewMsg creates a localizable message. Its String method renders the
+// message id without translation, see I18n.NewMsg.
+func NewMsg(id string, args ...interface{}) Msg {
+	return Msg{ID: id, Args: args}
+}
+
+// NewMsg creates a localizable message of the instance. It's rendered by
+// String in the fallback language and by Localize in the best loaded
+// language of the instance.
+func (i *I18n) NewMsg(id string, args ...interface{}) Msg {
+	return Msg{ID: id, Args: args, i18n: i}
+}
+
+// String renders the message in the fallback language of the instance which
+// created the message.
+func (i Msg) String() string {
+	if i.printer != nil {
+		return i.localize(i.printer)
+	}
+
+	return i.localize(fallbackPrinter(i.i18n))
+}
+
+// Localize renders the message in the language. A message of an instance
+// is rendered in the best loaded language of the instance or its fallback
+// language.
+func (i Msg) Localize(tag language.Tag) string {
+	if i.i18n != nil {
+		tag, _ = i.i18n.Match(tag)
+
+		return i.localize(i.i18n.Printer(tag))
+	}
+
+	return i.localize(message.NewPrinter(tag))
+}
+
+// Print renders the message with the printer, e.g. returned by I18n.Printer.
+func (i Msg) Print(p *message.Printer) string {
+	return i.localize(p)
+}
+
+// Bind returns a copy of the message bound to the printer stored in the
+// context, see NewContext. A bound message is rendered with the printer by
+// String and marshaled to JSON as a string.
+func (i Msg) Bind(ctx context.Context) Msg {
+	if p, ok := ctx.Value(printerKey{}).(*message.Printer); ok {
+		i.printer = p
+	}
+
+	return i
+}
+
+// Translate the message.
+func (i Msg) localize(p *message.Printer) string {
+	return p.Sprintf(i.ID, localizeArgs(p, i.Args)...)
+}
+
+// JSON representation of an unbound message.
+type jsonMsg struct {
+	ID   string        `json:"id"`
+	Args []interface{} `json:"args,omitempty"`
+}
+
+// MarshalJSON encodes a bound message as the rendered string, otherwise as
+// an object with id and arguments.
+func (i Msg) MarshalJSON() ([]byte, error) {
+	if i.printer != nil {
+		return json.Marshal(i.localize(i.printer))
+	}
+
+	return json.Marshal(jsonMsg{ID: i.ID, Args: i.Args})
+}
+
+// UnmarshalJSON decodes a message from an object with id and arguments.
+func (i *Msg) UnmarshalJSON(b []byte) error {
+	var m jsonMsg
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	*i = Msg{ID: m.ID, Args: m.Args}
+
+	return nil
+}
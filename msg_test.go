@@ -0,0 +1,90 @@
+package i18n
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+func Test_Msg(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, message.SetString(language.English, "msg items %d", "%d items"))
+	assert.NoError(t, message.SetString(language.Russian, "msg items %d", "%d предметов"))
+	assert.NoError(t, message.SetString(language.English, "msg cart %v", "Cart: %v"))
+	assert.NoError(t, message.SetString(language.Russian, "msg cart %v", "Корзина: %v"))
+
+	i18n := New(&Config{Fallback: language.English})
+	i18n.printer[language.English] = message.NewPrinter(language.English)
+	i18n.printer[language.Russian] = message.NewPrinter(language.Russian)
+
+	m := i18n.NewMsg("msg cart %v", i18n.NewMsg("msg items %d", 5))
+	ctx := NewContext(context.Background(), message.NewPrinter(language.Russian))
+
+	assert.Equal(t, "Cart: 5 items", m.String())
+	assert.Equal(t, "Cart: 5 items", fmt.Sprint(m))
+	assert.Equal(t, "Корзина: 5 предметов", m.Localize(language.Russian))
+	assert.Equal(t, "Корзина: 5 предметов", m.Localize(language.MustParse("ru-UA")))
+	assert.Equal(t, "Cart: 5 items", m.Localize(language.German))
+	assert.Equal(t, "Корзина: 5 предметов", m.Print(message.NewPrinter(language.Russian)))
+	assert.Equal(t, "Корзина: 5 предметов", m.Bind(ctx).String())
+	assert.Equal(t, "Cart: 5 items", m.Bind(context.Background()).String())
+
+	m = NewMsg("msg cart %v", NewMsg("msg items %d", 5))
+
+	assert.Equal(t, "msg cart msg items 5", m.String())
+	assert.Equal(t, "Корзина: 5 предметов", m.Localize(language.Russian))
+}
+
+func Test_MsgJSON(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, message.SetString(language.Russian, "msg hello %v", "Привет, %v"))
+
+	ctx := NewContext(context.Background(), message.NewPrinter(language.Russian))
+
+	testCases := []struct {
+		name string
+		msg  Msg
+		out  string
+	}{
+		{
+			name: "unbound message",
+			msg:  NewMsg("msg hello %v", "Ivan"),
+			out:  `{"id":"msg hello %v","args":["Ivan"]}`,
+		},
+		{
+			name: "unbound message without arguments",
+			msg:  NewMsg("msg hello %v"),
+			out:  `{"id":"msg hello %v"}`,
+		},
+		{
+			name: "bound message",
+			msg:  NewMsg("msg hello %v", "Ivan").Bind(ctx),
+			out:  `"Привет, Ivan"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name,
+			func(t *testing.T) {
+				b, err := json.Marshal(tc.msg)
+
+				assert.NoError(t, err)
+				assert.Equal(t, tc.out, string(b))
+			},
+		)
+	}
+
+	var m Msg
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"id":"msg hello %v","args":["Ivan"]}`), &m))
+	assert.Equal(t, NewMsg("msg hello %v", "Ivan"), m)
+	assert.Error(t, json.Unmarshal([]byte(`"Привет"`), &m))
+}
@@ -0,0 +1,220 @@
+package i18n
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"sync"
+
+	"golang.org/x/text/currency"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/number"
+)
+
+// Number formats a number with digit grouping and the decimal separator of
+// the printer language when passed as an argument of a printer:
+//
+//	p.Sprintf("Total: %v", i18n.Number(1234.5)) // Total: 1,234.5
+func Number(v interface{}, opts ...number.Option) number.Formatter {
+	return number.Decimal(v, opts...)
+}
+
+// Percent formats a fraction as a percent of the printer language, e.g.
+// 0.25 as `25%` or `25 %`.
+func Percent(v interface{}, opts ...number.Option) number.Formatter {
+	return number.Percent(v, opts...)
+}
+
+// Currency formats an amount of money with the currency symbol and decimals
+// of the currency and the symbol placement of the printer language, see
+// FormattingOf:
+//
+//	amount := i18n.Currency(1234.5, currency.EUR)
+//	en.Sprint(amount) // €1,234.50
+//	de.Sprint(amount) // 1.234,50 €
+func Currency(v interface{}, unit currency.Unit) fmt.Formatter {
+	return money{value: v, unit: unit}
+}
+
+// Compact formats a number in a short form of the printer language, e.g.
+// `1.2K` or `1,2 млн`, see FormattingOf.
+func Compact(v interface{}) fmt.Formatter {
+	return compactNumber{value: v}
+}
+
+// Amount of money.
+type money struct {
+	value interface{}
+	unit  currency.Unit
+}
+
+// Format the amount with the printer language.
+func (m money) Format(s fmt.State, verb rune) {
+	tag := stateLanguage(s)
+	p := numberPrinter(tag)
+
+	v, ok := toFloat(m.value)
+	if !ok {
+		fmt.Fprintf(s, "%%!%c(%T=%v)", verb, m.value, m.value)
+		return
+	}
+
+	scale, _ := currency.Standard.Rounding(m.unit)
+	symbol := p.Sprint(currency.Symbol(m.unit))
+	amount := p.Sprint(number.Decimal(math.Abs(v), number.Scale(scale)))
+
+	result := strings.Replace(currencyPattern(tag), "¤", symbol, 1)
+	result = strings.Replace(result, "0", amount, 1)
+
+	if v < 0 && amount != p.Sprint(number.Decimal(0, number.Scale(scale))) {
+		result = "-" + result
+	}
+
+	fmt.Fprint(s, result)
+}
+
+// Get the currency pattern of the language, see FormattingOf.
+func currencyPattern(tag language.Tag) string {
+	key, ok := localeKey(tag, func(key string) bool {
+		_, ok := currencyPatterns[key]
+		return ok
+	})
+	if !ok {
+		return "¤0"
+	}
+
+	return currencyPatterns[key]
+}
+
+// Number in a short form.
+type compactNumber struct {
+	value interface{}
+}
+
+// Compact unit: a power of ten and a pattern with `0` as the number.
+type compactUnit struct {
+	power   int
+	pattern string
+}
+
+// Format the number with the printer language. The number of units keeps
+// one fraction digit below 10 and is rounded to an integer above.
+func (c compactNumber) Format(s fmt.State, verb rune) {
+	tag := stateLanguage(s)
+
+	v, ok := toFloat(c.value)
+	if !ok {
+		fmt.Fprintf(s, "%%!%c(%T=%v)", verb, c.value, c.value)
+		return
+	}
+
+	key, ok := localeKey(tag, func(key string) bool {
+		_, ok := compactUnits[key]
+		return ok
+	})
+	if !ok {
+		key = "en"
+	}
+
+	units := compactUnits[key]
+
+	powers := make([]int, len(units))
+	for n, unit := range units {
+		powers[n] = unit.power
+	}
+
+	pattern, mantissa := "0", v
+
+	if n := compactPower(v, powers); n >= 0 {
+		pattern, mantissa = units[n].pattern, v/math.Pow10(units[n].power)
+	}
+
+	digits := 0
+	if math.Abs(roundCompact(math.Abs(mantissa))) < 10 {
+		digits = 1
+	}
+
+	text := numberPrinter(tag).Sprint(number.Decimal(
+		mantissa,
+		number.MaxFractionDigits(digits),
+		number.NoSeparator(),
+	))
+
+	fmt.Fprint(s, strings.Replace(pattern, "0", text, 1))
+}
+
+// Get the index of the largest power of ascending powers of units the number
+// reaches, -1 if none. Rounding may carry to the next unit, e.g. 999 999 is
+// 1M and 999.97 is 1K.
+func compactPower(v float64, powers []int) int {
+	v = math.Abs(v)
+
+	n := len(powers) - 1
+	for n >= 0 && v < math.Pow10(powers[n]) {
+		n--
+	}
+
+	if n+1 < len(powers) {
+		power := 0
+		if n >= 0 {
+			power = powers[n]
+		}
+
+		if roundCompact(v/math.Pow10(power)) >= math.Pow10(powers[n+1]-power) {
+			n++
+		}
+	}
+
+	return n
+}
+
+// Round a positive number to one fraction digit below 10 and to an integer
+// above.
+func roundCompact(v float64) float64 {
+	if v < 10 {
+		return math.Round(v*10) / 10
+	}
+
+	return math.Round(v)
+}
+
+// Printers to format numbers by language.
+var numberPrinters sync.Map
+
+// Get a printer to format numbers of the language.
+func numberPrinter(tag language.Tag) *message.Printer {
+	if p, ok := numberPrinters.Load(tag); ok {
+		return p.(*message.Printer)
+	}
+
+	p, _ := numberPrinters.LoadOrStore(tag, message.NewPrinter(tag))
+
+	return p.(*message.Printer)
+}
+
+// Get the language of a printer state, Und for the fmt package.
+func stateLanguage(s fmt.State) language.Tag {
+	if s, ok := s.(interface{ Language() language.Tag }); ok {
+		return s.Language()
+	}
+
+	return language.Und
+}
+
+// Convert a number to float64.
+func toFloat(v interface{}) (float64, bool) {
+	value := reflect.ValueOf(v)
+
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(value.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(value.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return value.Float(), true
+	}
+
+	return 0, false
+}
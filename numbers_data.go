@@ -0,0 +1,39 @@
+package i18n
+
+// Standard currency patterns of languages, `¤` is the symbol and `0` is the
+// amount. Languages which are not listed put the symbol before the amount.
+var currencyPatterns = map[string]string{
+	"en": "¤0", "fil": "¤0", "hi": "¤0", "id": "¤0",
+	"ja": "¤0", "ko": "¤0", "ms": "¤0", "th": "¤0",
+	"tr": "¤0", "zh": "¤0", "zh-Hant": "¤0",
+
+	"bg": "0 ¤", "ca": "0 ¤", "cs": "0 ¤", "da": "0 ¤",
+	"de": "0 ¤", "el": "0 ¤", "es": "0 ¤", "et": "0 ¤",
+	"fi": "0 ¤", "fr": "0 ¤", "hr": "0 ¤", "hu": "0 ¤",
+	"it": "0 ¤", "kk": "0 ¤", "lt": "0 ¤", "lv": "0 ¤",
+	"nb": "0 ¤", "pl": "0 ¤", "ro": "0 ¤", "ru": "0 ¤",
+	"sk": "0 ¤", "sl": "0 ¤", "sr": "0 ¤", "sv": "0 ¤",
+	"uk": "0 ¤", "vi": "0 ¤", "be": "0 ¤", "ar": "0 ¤",
+
+	"de-AT": "¤ 0", "de-CH": "¤ 0", "nl": "¤ 0", "pt": "¤ 0",
+	"pt-PT": "0 ¤",
+}
+
+// Short compact units of languages. Languages which are not listed use
+// English units.
+var compactUnits = map[string][]compactUnit{
+	"en":      {{3, "0K"}, {6, "0M"}, {9, "0B"}, {12, "0T"}},
+	"de":      {{6, "0 Mio."}, {9, "0 Mrd."}, {12, "0 Bio."}},
+	"es":      {{3, "0 mil"}, {6, "0 M"}, {12, "0 B"}},
+	"fr":      {{3, "0 k"}, {6, "0 M"}, {9, "0 Md"}, {12, "0 Bn"}},
+	"it":      {{6, "0 Mln"}, {9, "0 Mrd"}, {12, "0 Bln"}},
+	"ja":      {{4, "0万"}, {8, "0億"}, {12, "0兆"}},
+	"ko":      {{3, "0천"}, {4, "0만"}, {8, "0억"}, {12, "0조"}},
+	"pl":      {{3, "0 tys."}, {6, "0 mln"}, {9, "0 mld"}, {12, "0 bln"}},
+	"pt":      {{3, "0 mil"}, {6, "0 mi"}, {9, "0 bi"}, {12, "0 tri"}},
+	"ru":      {{3, "0 тыс."}, {6, "0 млн"}, {9, "0 млрд"}, {12, "0 трлн"}},
+	"uk":      {{3, "0 тис."}, {6, "0 млн"}, {9, "0 млрд"}, {12, "0 трлн"}},
+	"zh":      {{4, "0万"}, {8, "0亿"}, {12, "0万亿"}},
+	"zh-Hant": {{4, "0萬"}, {8, "0億"}, {12, "0兆"}},
+	"ar":      {{3, "0 ألف"}, {6, "0 مليون"}, {9, "0 مليار"}, {12, "0 ترليون"}},
+}
@@ -0,0 +1,64 @@
+package i18n
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/currency"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+func Test_Numbers(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+		tag  language.Tag
+		arg  interface{}
+		out  string
+	}{
+		{name: "number en", tag: language.English, arg: Number(1234567.891), out: "1,234,567.891"},
+		{name: "number de", tag: language.German, arg: Number(1234567.891), out: "1.234.567,891"},
+		{name: "percent en", tag: language.English, arg: Percent(0.256), out: "26%"},
+		{name: "percent de", tag: language.German, arg: Percent(0.256), out: "26 %"},
+		{name: "currency en", tag: language.English, arg: Currency(1234.5, currency.EUR), out: "€1,234.50"},
+		{name: "currency de", tag: language.German, arg: Currency(1234.5, currency.EUR), out: "1.234,50 €"},
+		{name: "currency nl", tag: language.Dutch, arg: Currency(1234.5, currency.EUR), out: "€ 1.234,50"},
+		{name: "currency negative", tag: language.English, arg: Currency(-5, currency.USD), out: "-$5.00"},
+		{name: "currency zero decimals", tag: language.Japanese, arg: Currency(1234.4, currency.JPY), out: "￥1,234"},
+		{name: "currency three decimals", tag: language.English, arg: Currency(1.5, currency.MustParseISO("KWD")), out: "KWD1.500"},
+		{name: "compact small", tag: language.English, arg: Compact(999), out: "999"},
+		{name: "compact en", tag: language.English, arg: Compact(1234), out: "1.2K"},
+		{name: "compact carry", tag: language.English, arg: Compact(999999), out: "1M"},
+		{name: "compact carry into unit", tag: language.English, arg: Compact(999.97), out: "1K"},
+		{name: "compact without carry", tag: language.English, arg: Compact(950000), out: "950K"},
+		{name: "compact integer", tag: language.English, arg: Compact(25300000), out: "25M"},
+		{name: "compact negative", tag: language.English, arg: Compact(-1500), out: "-1.5K"},
+		{name: "compact ru", tag: language.Russian, arg: Compact(1234), out: "1,2 тыс."},
+		{name: "compact de", tag: language.German, arg: Compact(25300000), out: "25 Mio."},
+		{name: "compact ja", tag: language.Japanese, arg: Compact(25300000), out: "2530万"},
+		{name: "compact language without units", tag: language.Dutch, arg: Compact(1234), out: "1,2K"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name,
+			func(t *testing.T) {
+				p := message.NewPrinter(tc.tag)
+
+				assert.Equal(t, tc.out, p.Sprint(tc.arg))
+			},
+		)
+	}
+}
+
+func Test_NumbersMessage(t *testing.T) {
+	t.Parallel()
+
+	p := message.NewPrinter(language.German)
+
+	assert.Equal(t, "Total: 9,99 $", p.Sprintf("Total: %v", Currency(9.99, currency.USD)))
+	assert.Equal(t, "%!v(string=x)", fmt.Sprint(Compact("x")))
+}
@@ -0,0 +1,812 @@
+package i18n
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// Encode messages as gettext PO file. The Plural-Forms header is the gettext
+// plural expression of the language, plural forms `msgstr[n]` are texts of
+// plural categories in the order of the expression. Exact values `=N` and
+// comparisons `<N` have no gettext form, they are written as comments and
+// restored by the PO decoder. A message with several plural arguments
+// can't be exported.
+func encodePO(w io.Writer, tag language.Tag, m []translationMessage) error {
+	b := bufio.NewWriter(w)
+
+	forms, formsOK := pluralFormsOf(tag)
+
+	fmt.Fprintf(b, "msgid \"\"\nmsgstr \"\"\n")
+	fmt.Fprintf(b, "\"Language: %v\\n\"\n", tag)
+	fmt.Fprintf(b, "\"Content-Type: text/plain; charset=UTF-8\\n\"\n")
+
+	if formsOK {
+		fmt.Fprintf(b, "\"Plural-Forms: %v\\n\"\n", forms.expression)
+	}
+
+	for _, message := range m {
+		if message.Message != nil {
+			writePOComments(b, message)
+			writePOContext(b, message)
+			fmt.Fprintf(b, "msgid %v\nmsgstr %v\n", quotePO(message.ID), quotePO(*message.Message))
+			continue
+		}
+
+		args := pluralArguments(message.Rules)
+
+		switch {
+		case len(args) > 1:
+			return &ErrorEncode{Format: FormatPO, MessageID: message.ID, Message: "several plural arguments"}
+		case len(args) == 0:
+			continue
+		case !formsOK:
+			return &ErrorEncode{Format: FormatPO, MessageID: message.ID, Message: "no plural forms of the language"}
+		}
+
+		arg := args[0]
+
+		writePOComments(b, message)
+
+		if arg.number != 1 {
+			fmt.Fprintf(b, "#. plural argument: %v\n", arg.number)
+		}
+
+		for _, selector := range arg.selectors {
+			if selectorOrder(selector) <= 1 {
+				fmt.Fprintf(b, "#. plural %v: %v\n", selector, quotePO(arg.messages[selector]))
+			}
+		}
+
+		writePOContext(b, message)
+		fmt.Fprintf(b, "msgid %v\nmsgid_plural %v\n", quotePO(message.ID), quotePO(message.ID))
+
+		for n, category := range forms.categories {
+			fmt.Fprintf(b, "msgstr[%v] %v\n", n, quotePO(pluralForm(arg.messages, category)))
+		}
+	}
+
+	return b.Flush()
+}
+
+// Write translator comments of the message preceded by an empty line.
+func writePOComments(w io.Writer, message translationMessage) {
+	fmt.Fprintln(w)
+
+	if message.Description != "" {
+		for _, line := range strings.Split(message.Description, "\n") {
+			fmt.Fprintf(w, "#. %v\n", line)
+		}
+	}
+
+	if message.MaxLength > 0 {
+		fmt.Fprintf(w, "#. max length: %v\n", message.MaxLength)
+	}
+
+	if message.Hash != "" {
+		fmt.Fprintf(w, "#. hash: %v\n", message.Hash)
+	}
+}
+
+// Write the message context.
+func writePOContext(w io.Writer, message translationMessage) {
+	if message.Context != "" {
+		fmt.Fprintf(w, "msgctxt %v\n", quotePO(message.Context))
+	}
+}
+
+// Quote a PO string.
+func quotePO(s string) string {
+	return `"` + strings.NewReplacer(
+		`\`, `\\`,
+		`"`, `\"`,
+		"\n", `\n`,
+		"\t", `\t`,
+	).Replace(s) + `"`
+}
+
+// Unquote a PO string.
+func unquotePO(s string) (string, bool) {
+	if len(s) < 2 || s[0] != '"' || s[len(s)-1] != '"' {
+		return "", false
+	}
+
+	return strings.NewReplacer(
+		`\\`, `\`,
+		`\"`, `"`,
+		`\n`, "\n",
+		`\t`, "\t",
+	).Replace(s[1 : len(s)-1]), true
+}
+
+// Gettext plural forms: the header expression and plural categories of
+// plural indices.
+type gettextPlural struct {
+	expression string
+	categories []string
+}
+
+// Gettext plural forms of CLDR rules, indices are in CLDR order.
+var gettextPlurals = []gettextPlural{
+	{"nplurals=1; plural=0;", []string{"other"}},
+	{"nplurals=2; plural=(n != 1);", []string{"one", "other"}},
+	{"nplurals=2; plural=(n > 1);", []string{"one", "other"}},
+	{"nplurals=2; plural=(n % 10 != 1 || n % 100 == 11);", []string{"one", "other"}},
+	{"nplurals=2; plural=(n % 10 != 1);", []string{"one", "other"}},
+	{"nplurals=2; plural=(n <= 1 || n >= 11 && n <= 99 ? 0 : 1);", []string{"one", "other"}},
+	{
+		"nplurals=2; plural=(n == 1 || n == 2 || n == 3 || n % 10 != 4 && n % 10 != 6 && n % 10 != 9 ? 0 : 1);",
+		[]string{"one", "other"},
+	},
+	{"nplurals=3; plural=(n == 0 ? 0 : n == 1 ? 1 : 2);", []string{"zero", "one", "other"}},
+	{"nplurals=3; plural=(n == 1 ? 0 : n == 2 ? 1 : 2);", []string{"one", "two", "other"}},
+	{
+		"nplurals=3; plural=(n % 10 == 1 && n % 100 != 11 ? 0 : " +
+			"n % 10 >= 2 && n % 10 <= 4 && (n % 100 < 12 || n % 100 > 14) ? 1 : 2);",
+		[]string{"one", "few", "many"},
+	},
+	{
+		"nplurals=3; plural=(n % 10 == 1 && n % 100 != 11 ? 0 : " +
+			"n % 10 >= 2 && n % 10 <= 4 && (n % 100 < 12 || n % 100 > 14) ? 1 : 2);",
+		[]string{"one", "few", "other"},
+	},
+	{
+		"nplurals=3; plural=(n == 1 ? 0 : n % 10 >= 2 && n % 10 <= 4 && (n % 100 < 12 || n % 100 > 14) ? 1 : 2);",
+		[]string{"one", "few", "many"},
+	},
+	{"nplurals=3; plural=(n == 1 ? 0 : n >= 2 && n <= 4 ? 1 : 2);", []string{"one", "few", "other"}},
+	{
+		"nplurals=3; plural=(n % 10 == 1 && (n % 100 < 11 || n % 100 > 19) ? 0 : " +
+			"n % 10 >= 2 && n % 10 <= 9 && (n % 100 < 11 || n % 100 > 19) ? 1 : 2);",
+		[]string{"one", "few", "other"},
+	},
+	{
+		"nplurals=3; plural=(n % 10 == 0 || n % 100 >= 11 && n % 100 <= 19 ? 0 : n % 10 == 1 && n % 100 != 11 ? 1 : 2);",
+		[]string{"zero", "one", "other"},
+	},
+	{"nplurals=3; plural=(n == 1 ? 0 : n == 0 || n % 100 >= 1 && n % 100 <= 19 ? 1 : 2);", []string{"one", "few", "other"}},
+	{"nplurals=3; plural=(n == 0 || n == 1 ? 0 : n >= 2 && n <= 10 ? 1 : 2);", []string{"one", "few", "other"}},
+	{
+		"nplurals=4; plural=(n % 100 == 1 ? 0 : n % 100 == 2 ? 1 : n % 100 == 3 || n % 100 == 4 ? 2 : 3);",
+		[]string{"one", "two", "few", "other"},
+	},
+	{
+		"nplurals=4; plural=(n == 1 || n == 11 ? 0 : n == 2 || n == 12 ? 1 : " +
+			"n >= 3 && n <= 10 || n >= 13 && n <= 19 ? 2 : 3);",
+		[]string{"one", "two", "few", "other"},
+	},
+	{"nplurals=4; plural=(n % 10 == 1 ? 0 : n % 10 == 2 ? 1 : n % 20 == 0 ? 2 : 3);", []string{"one", "two", "few", "other"}},
+	{"nplurals=4; plural=(n == 1 ? 0 : n == 2 ? 1 : n > 10 && n % 10 == 0 ? 2 : 3);", []string{"one", "two", "many", "other"}},
+	{
+		"nplurals=4; plural=(n == 1 ? 0 : n == 0 || n % 100 >= 2 && n % 100 <= 10 ? 1 : " +
+			"n % 100 >= 11 && n % 100 <= 19 ? 2 : 3);",
+		[]string{"one", "few", "many", "other"},
+	},
+	{
+		"nplurals=5; plural=(n == 1 ? 0 : n == 2 ? 1 : n >= 3 && n <= 6 ? 2 : n >= 7 && n <= 10 ? 3 : 4);",
+		[]string{"one", "two", "few", "many", "other"},
+	},
+	{
+		"nplurals=5; plural=(n % 10 == 1 && n % 100 != 11 && n % 100 != 71 && n % 100 != 91 ? 0 : " +
+			"n % 10 == 2 && n % 100 != 12 && n % 100 != 72 && n % 100 != 92 ? 1 : " +
+			"(n % 10 == 3 || n % 10 == 4 || n % 10 == 9) && (n % 100 < 10 || n % 100 > 19) && " +
+			"(n % 100 < 70 || n % 100 > 79) && (n % 100 < 90 || n % 100 > 99) ? 2 : " +
+			"n != 0 && n % 1000000 == 0 ? 3 : 4);",
+		[]string{"one", "two", "few", "many", "other"},
+	},
+	{
+		"nplurals=6; plural=(n == 0 ? 0 : n == 1 ? 1 : n == 2 ? 2 : " +
+			"n % 100 >= 3 && n % 100 <= 10 ? 3 : n % 100 >= 11 ? 4 : 5);",
+		[]string{"zero", "one", "two", "few", "many", "other"},
+	},
+	{
+		"nplurals=6; plural=(n == 0 ? 0 : n == 1 ? 1 : n == 2 ? 2 : n == 3 ? 3 : n == 6 ? 4 : 5);",
+		[]string{"zero", "one", "two", "few", "many", "other"},
+	},
+}
+
+// Integers checked to match a plural expression with CLDR rules.
+var pluralSamples = func() []int {
+	result := make([]int, 0, 1003)
+	for n := 0; n < 1000; n++ {
+		result = append(result, n)
+	}
+
+	return append(result, 1000000, 1000001, 2000000)
+}()
+
+// Get gettext plural forms of the language: the first expression which
+// selects the CLDR category of every sample integer.
+func pluralFormsOf(tag language.Tag) (gettextPlural, bool) {
+	for _, p := range gettextPlurals {
+		expression, _, err := parsePluralForms(p.expression)
+		if err != nil {
+			continue
+		}
+
+		ok := true
+
+		for _, n := range pluralSamples {
+			if index := expression(n); index < 0 || index >= len(p.categories) ||
+				p.categories[index] != pluralCategory(tag, n, 0, 0) {
+				ok = false
+				break
+			}
+		}
+
+		if ok {
+			return p, true
+		}
+	}
+
+	return gettextPlural{}, false
+}
+
+// Get plural categories of gettext plural indices of the Plural-Forms
+// header: the CLDR category of the first sample integer with the index.
+// An index without sample integers is skipped.
+func pluralFormsCategories(tag language.Tag, header string) ([]string, error) {
+	expression, count, err := parsePluralForms(header)
+	if err != nil {
+		return nil, err
+	}
+
+	categories := make([]string, count)
+
+	for _, n := range pluralSamples {
+		if index := expression(n); index >= 0 && index < count && categories[index] == "" {
+			categories[index] = pluralCategory(tag, n, 0, 0)
+		}
+	}
+
+	return categories, nil
+}
+
+// Parse a Plural-Forms header `nplurals=N; plural=EXPRESSION;` to a function
+// of n and the number of plural forms.
+func parsePluralForms(header string) (func(n int) int, int, error) {
+	var count int
+	var expression func(n int) int
+
+	for _, part := range strings.Split(header, ";") {
+		key, value, _ := strings.Cut(part, "=")
+
+		switch strings.TrimSpace(key) {
+		case "nplurals":
+			n, err := strconv.Atoi(strings.TrimSpace(value))
+			if err != nil || n < 1 {
+				return nil, 0, fmt.Errorf("wrong nplurals `%v`", strings.TrimSpace(value))
+			}
+
+			count = n
+		case "plural":
+			p := &pluralParser{s: value}
+
+			e, err := p.parse()
+			if err != nil {
+				return nil, 0, err
+			}
+
+			expression = e
+		}
+	}
+
+	if count == 0 || expression == nil {
+		return nil, 0, fmt.Errorf("wrong plural forms `%v`", header)
+	}
+
+	return expression, count, nil
+}
+
+// Parser of gettext plural expressions, a subset of C expressions of n.
+type pluralParser struct {
+	s   string
+	pos int
+}
+
+// Parse the expression.
+func (p *pluralParser) parse() (func(n int) int, error) {
+	e, err := p.ternary()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.skipSpace(); p.pos < len(p.s) {
+		return nil, fmt.Errorf("unexpected `%v` in plural expression", p.s[p.pos:])
+	}
+
+	return e, nil
+}
+
+// Parse a conditional expression.
+func (p *pluralParser) ternary() (func(n int) int, error) {
+	cond, err := p.binary(0)
+	if err != nil || !p.consume("?") {
+		return cond, err
+	}
+
+	yes, err := p.ternary()
+	if err != nil {
+		return nil, err
+	}
+
+	if !p.consume(":") {
+		return nil, fmt.Errorf("`:` expected in plural expression")
+	}
+
+	no, err := p.ternary()
+	if err != nil {
+		return nil, err
+	}
+
+	return func(n int) int {
+		if cond(n) != 0 {
+			return yes(n)
+		}
+
+		return no(n)
+	}, nil
+}
+
+// Binary operators by precedence from the lowest.
+var pluralOperators = [][]string{
+	{"||"},
+	{"&&"},
+	{"==", "!="},
+	{"<=", ">=", "<", ">"},
+	{"+", "-"},
+	{"*", "/", "%"},
+}
+
+// Parse a binary expression of operators with the precedence and higher.
+func (p *pluralParser) binary(level int) (func(n int) int, error) {
+	if level == len(pluralOperators) {
+		return p.unary()
+	}
+
+	left, err := p.binary(level + 1)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		op := ""
+
+		for _, o := range pluralOperators[level] {
+			if p.consume(o) {
+				op = o
+				break
+			}
+		}
+
+		if op == "" {
+			return left, nil
+		}
+
+		right, err := p.binary(level + 1)
+		if err != nil {
+			return nil, err
+		}
+
+		left = pluralOperator(op, left, right)
+	}
+}
+
+// Get a function of the binary operator.
+func pluralOperator(op string, a, b func(n int) int) func(n int) int {
+	toInt := func(v bool) int {
+		if v {
+			return 1
+		}
+
+		return 0
+	}
+
+	return func(n int) int {
+		x, y := a(n), b(n)
+
+		switch op {
+		case "||":
+			return toInt(x != 0 || y != 0)
+		case "&&":
+			return toInt(x != 0 && y != 0)
+		case "==":
+			return toInt(x == y)
+		case "!=":
+			return toInt(x != y)
+		case "<=":
+			return toInt(x <= y)
+		case ">=":
+			return toInt(x >= y)
+		case "<":
+			return toInt(x < y)
+		case ">":
+			return toInt(x > y)
+		case "+":
+			return x + y
+		case "-":
+			return x - y
+		case "*":
+			return x * y
+		}
+
+		if y == 0 {
+			return 0
+		}
+
+		if op == "/" {
+			return x / y
+		}
+
+		return x % y
+	}
+}
+
+// Parse a negation, a number, n or an expression in parentheses.
+func (p *pluralParser) unary() (func(n int) int, error) {
+	p.skipSpace()
+
+	switch {
+	case p.consume("!"):
+		e, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+
+		return func(n int) int {
+			if e(n) == 0 {
+				return 1
+			}
+
+			return 0
+		}, nil
+	case p.consume("("):
+		e, err := p.ternary()
+		if err != nil {
+			return nil, err
+		}
+
+		if !p.consume(")") {
+			return nil, fmt.Errorf("`)` expected in plural expression")
+		}
+
+		return e, nil
+	case p.consume("n"):
+		return func(n int) int { return n }, nil
+	}
+
+	start := p.pos
+	for p.pos < len(p.s) && p.s[p.pos] >= '0' && p.s[p.pos] <= '9' {
+		p.pos++
+	}
+
+	v, err := strconv.Atoi(p.s[start:p.pos])
+	if err != nil {
+		return nil, fmt.Errorf("unexpected `%v` in plural expression", p.s[start:])
+	}
+
+	return func(int) int { return v }, nil
+}
+
+// Skip spaces and consume the token if it's next.
+func (p *pluralParser) consume(token string) bool {
+	p.skipSpace()
+
+	if !strings.HasPrefix(p.s[p.pos:], token) {
+		return false
+	}
+
+	// `<` and `>` are not the start of `<=` and `>=`, `!` isn't `!=`.
+	if len(token) == 1 && strings.Contains("<>!", token) && strings.HasPrefix(p.s[p.pos+1:], "=") {
+		return false
+	}
+
+	p.pos += len(token)
+
+	return true
+}
+
+// Skip spaces.
+func (p *pluralParser) skipSpace() {
+	for p.pos < len(p.s) && (p.s[p.pos] == ' ' || p.s[p.pos] == '\t') {
+		p.pos++
+	}
+}
+
+// Decoder of gettext PO files. Translator comments `#.` are the message
+// description, `msgctxt` is the message context. Plural forms are converted
+// to plural rules with the Plural-Forms header and the Language header, the
+// last form is also used for `other`. Comments written by the PO export,
+// e.g. `#. max length: 10` or `#. plural =0: "none"`, are restored. Fuzzy,
+// obsolete and untranslated messages are skipped.
+type poDecoder struct{}
+
+// Entry of a PO file.
+type poEntry struct {
+	comments []string
+	flags    []string
+	context  string
+	id       string
+	plural   bool
+	text     string
+	forms    map[int]string
+}
+
+// Decode translation messages.
+func (d poDecoder) Decode(data []byte) ([]Message, error) {
+	return publicMessages(d.decode(data))
+}
+
+// Decode translation messages.
+func (poDecoder) decode(data []byte) ([]translationMessage, error) {
+	entries, err := parsePO(string(data))
+	if err != nil {
+		return nil, err
+	}
+
+	var result []translationMessage
+	var header map[string]string
+	var categories []string
+
+	for _, entry := range entries {
+		if entry.id == "" {
+			header = poHeader(entry.text)
+			continue
+		}
+
+		if entry.fuzzy() {
+			continue
+		}
+
+		m := translationMessage{ID: entry.id, Context: entry.context}
+		selectors := make(map[string]string)
+		number := 1
+
+		var description []string
+
+		for _, comment := range entry.comments {
+			key, value, _ := strings.Cut(comment, ": ")
+
+			switch {
+			case key == "max length":
+				if m.MaxLength, err = strconv.Atoi(value); err != nil {
+					return nil, &ErrorDecode{Format: "po", Message: fmt.Sprintf("message `%v`: wrong max length", m.ID)}
+				}
+			case key == "hash":
+				m.Hash = value
+			case key == "plural argument":
+				if number, err = strconv.Atoi(value); err != nil {
+					return nil, &ErrorDecode{Format: "po", Message: fmt.Sprintf("message `%v`: wrong plural argument", m.ID)}
+				}
+			case strings.HasPrefix(key, "plural ") && selectorOrder(key[len("plural "):]) <= 1:
+				text, ok := unquotePO(value)
+				if !ok {
+					return nil, &ErrorDecode{Format: "po", Message: fmt.Sprintf("message `%v`: wrong plural comment", m.ID)}
+				}
+
+				selectors[key[len("plural "):]] = text
+			default:
+				description = append(description, comment)
+			}
+		}
+
+		m.Description = strings.Join(description, "\n")
+
+		if !entry.plural {
+			if entry.text == "" {
+				continue
+			}
+
+			text := entry.text
+			m.Message = &text
+			result = append(result, m)
+
+			continue
+		}
+
+		if entry.forms[0] == "" {
+			continue
+		}
+
+		if categories == nil {
+			if categories, err = poCategories(header); err != nil {
+				return nil, err
+			}
+		}
+
+		rules := make(map[string]interface{}, len(categories)+len(selectors))
+
+		for n, category := range categories {
+			if text := entry.forms[n]; category != "" && text != "" {
+				rules[category] = text
+			}
+		}
+
+		if _, ok := rules["other"]; !ok {
+			rules["other"] = entry.forms[len(entry.forms)-1]
+		}
+
+		for selector, text := range selectors {
+			rules[selector] = text
+		}
+
+		m.Rules = rules
+		if number != 1 {
+			m.Rules = map[string]interface{}{strconv.Itoa(number): rules}
+		}
+
+		result = append(result, m)
+	}
+
+	sort.SliceStable(result, func(a, b int) bool { return result[a].ID < result[b].ID })
+
+	return result, nil
+}
+
+// Check if the entry is marked fuzzy.
+func (e *poEntry) fuzzy() bool {
+	for _, flag := range e.flags {
+		if flag == "fuzzy" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Get plural categories of plural forms of the header.
+func poCategories(header map[string]string) ([]string, error) {
+	tag, err := language.Parse(header["Language"])
+	if err != nil {
+		return nil, &ErrorDecode{Format: "po", Message: "language is required for plural forms"}
+	}
+
+	forms, ok := header["Plural-Forms"]
+	if !ok {
+		p, ok := pluralFormsOf(tag)
+		if !ok {
+			return nil, &ErrorDecode{Format: "po", Message: "plural forms are required"}
+		}
+
+		return p.categories, nil
+	}
+
+	categories, err := pluralFormsCategories(tag, forms)
+	if err != nil {
+		return nil, &ErrorDecode{Format: "po", Message: err.Error()}
+	}
+
+	return categories, nil
+}
+
+// Parse header fields of the header entry text.
+func poHeader(text string) map[string]string {
+	result := make(map[string]string)
+
+	for _, line := range strings.Split(text, "\n") {
+		if key, value, ok := strings.Cut(line, ":"); ok {
+			result[strings.TrimSpace(key)] = strings.TrimSpace(value)
+		}
+	}
+
+	return result
+}
+
+// Parse entries of a PO file. Obsolete entries `#~` and comments other
+// than translator comments and flags are skipped.
+func parsePO(data string) ([]*poEntry, error) {
+	var result []*poEntry
+
+	entry := &poEntry{forms: make(map[int]string)}
+
+	// The entry has a msgid or a msgstr keyword.
+	var hasID, hasText bool
+
+	// Appends a continuation string to the field of the last keyword.
+	var appendText func(s string)
+
+	flush := func() {
+		if hasID {
+			result = append(result, entry)
+		}
+
+		entry = &poEntry{forms: make(map[int]string)}
+		hasID, hasText, appendText = false, false, nil
+	}
+
+	lines := strings.Split(strings.ReplaceAll(data, "\r\n", "\n"), "\n")
+
+	for n, line := range lines {
+		line = strings.TrimSpace(line)
+
+		errorf := func(message string) error {
+			return &ErrorDecode{Format: "po", Message: fmt.Sprintf("line %v: %v", n+1, message)}
+		}
+
+		switch {
+		case line == "":
+			flush()
+			continue
+		case strings.HasPrefix(line, "#"):
+			if hasText {
+				flush()
+			}
+
+			appendText = nil
+
+			switch {
+			case strings.HasPrefix(line, "#."):
+				entry.comments = append(entry.comments, strings.TrimSpace(line[2:]))
+			case strings.HasPrefix(line, "#,"):
+				for _, flag := range strings.Split(line[2:], ",") {
+					entry.flags = append(entry.flags, strings.TrimSpace(flag))
+				}
+			}
+
+			continue
+		case strings.HasPrefix(line, `"`):
+			text, ok := unquotePO(line)
+			if !ok {
+				return nil, errorf("wrong string")
+			}
+
+			if appendText == nil {
+				return nil, errorf("string without a keyword")
+			}
+
+			appendText(text)
+
+			continue
+		}
+
+		keyword, value, _ := strings.Cut(line, " ")
+
+		text, ok := unquotePO(strings.TrimSpace(value))
+		if !ok {
+			return nil, errorf("wrong string")
+		}
+
+		if (keyword == "msgctxt" || keyword == "msgid") && hasID {
+			flush()
+		}
+
+		current := entry
+
+		switch {
+		case keyword == "msgctxt":
+			current.context = text
+			appendText = func(s string) { current.context += s }
+		case keyword == "msgid":
+			hasID = true
+			current.id = text
+			appendText = func(s string) { current.id += s }
+		case keyword == "msgid_plural":
+			current.plural = true
+			appendText = func(string) {}
+		case keyword == "msgstr":
+			hasText = true
+			current.text = text
+			appendText = func(s string) { current.text += s }
+		case strings.HasPrefix(keyword, "msgstr[") && strings.HasSuffix(keyword, "]"):
+			index, err := strconv.Atoi(keyword[len("msgstr[") : len(keyword)-1])
+			if err != nil || index < 0 {
+				return nil, errorf(fmt.Sprintf("wrong keyword `%v`", keyword))
+			}
+
+			hasText = true
+			current.forms[index] = text
+			appendText = func(s string) { current.forms[index] += s }
+		default:
+			return nil, errorf(fmt.Sprintf("unknown keyword `%v`", keyword))
+		}
+	}
+
+	flush()
+
+	return result, nil
+}
@@ -0,0 +1,274 @@
+package i18n
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+)
+
+// PO file with Polish translations.
+const testPO = `# Translator comment.
+msgid ""
+msgstr ""
+"Language: pl\n"
+"Plural-Forms: nplurals=3; plural=(n==1 ? 0 : n%10>=2 && n%10<=4 && (n%100<10 || n%100>=20) ? 1 : 2);\n"
+
+#. Greeting
+#. max length: 20
+#: main.go:10
+msgctxt "header"
+msgid "po hello %v"
+msgstr ""
+"Cześć, "
+"%v!"
+
+#. plural argument: 2
+#. plural =0: "brak plików w %[1]v"
+msgid "po %[1]v has %[2]d files"
+msgid_plural "po %[1]v has %[2]d files"
+msgstr[0] "%[2]d plik w %[1]v"
+msgstr[1] "%[2]d pliki w %[1]v"
+msgstr[2] "%[2]d plików w %[1]v"
+
+#, fuzzy
+msgid "po fuzzy"
+msgstr "Niepewne"
+
+msgid "po untranslated"
+msgstr ""
+
+#~ msgid "po obsolete"
+#~ msgstr "Stare"
+`
+
+func Test_PODecode(t *testing.T) {
+	t.Parallel()
+
+	text := func(s string) *string { return &s }
+
+	m, err := poDecoder{}.decode([]byte(testPO))
+	assert.NoError(t, err)
+
+	assert.Equal(t, []translationMessage{
+		{
+			ID: "po %[1]v has %[2]d files",
+			Rules: map[string]interface{}{
+				"2": map[string]interface{}{
+					"=0":    "brak plików w %[1]v",
+					"one":   "%[2]d plik w %[1]v",
+					"few":   "%[2]d pliki w %[1]v",
+					"many":  "%[2]d plików w %[1]v",
+					"other": "%[2]d plików w %[1]v",
+				},
+			},
+		},
+		{
+			ID:          "po hello %v",
+			Message:     text("Cześć, %v!"),
+			Description: "Greeting",
+			Context:     "header",
+			MaxLength:   20,
+		},
+	}, m)
+}
+
+func Test_PODecodeErrors(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+		data string
+	}{
+		{name: "unknown keyword", data: "msgid \"a\"\nmsgtext \"b\"\n"},
+		{name: "wrong string", data: "msgid \"a\nmsgstr \"b\"\n"},
+		{name: "string without keyword", data: "\"a\"\n"},
+		{name: "wrong plural index", data: "msgid \"a\"\nmsgid_plural \"a\"\nmsgstr[x] \"b\"\n"},
+		{
+			name: "plural without language",
+			data: "msgid \"a\"\nmsgid_plural \"a\"\nmsgstr[0] \"b\"\n",
+		},
+		{
+			name: "wrong plural forms",
+			data: "msgid \"\"\nmsgstr \"Language: ru\\nPlural-Forms: nplurals=2; plural=(n;\\n\"\n\n" +
+				"msgid \"a\"\nmsgid_plural \"a\"\nmsgstr[0] \"b\"\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name,
+			func(t *testing.T) {
+				_, err := poDecoder{}.decode([]byte(tc.data))
+				assert.Error(t, err)
+			},
+		)
+	}
+}
+
+func Test_EncodePO(t *testing.T) {
+	t.Parallel()
+
+	m := []translationMessage{
+		{
+			ID:    "po apples",
+			Rules: map[string]interface{}{"=0": "no apples", "one": "%d apple", "other": "%d apples"},
+		},
+		{
+			ID:    "po %v has %d files",
+			Rules: map[string]interface{}{"2": map[string]interface{}{"one": "%v has %d file", "other": "%v has %d files"}},
+		},
+	}
+
+	var out bytes.Buffer
+
+	assert.NoError(t, encodePO(&out, language.English, m))
+	assert.Equal(t, `msgid ""
+msgstr ""
+"Language: en\n"
+"Content-Type: text/plain; charset=UTF-8\n"
+"Plural-Forms: nplurals=2; plural=(n != 1);\n"
+
+#. plural =0: "no apples"
+msgid "po apples"
+msgid_plural "po apples"
+msgstr[0] "%d apple"
+msgstr[1] "%d apples"
+
+#. plural argument: 2
+msgid "po %v has %d files"
+msgid_plural "po %v has %d files"
+msgstr[0] "%v has %d file"
+msgstr[1] "%v has %d files"
+`, out.String())
+
+	decoded, err := poDecoder{}.decode(out.Bytes())
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, m, decoded)
+
+	err = encodePO(&out, language.English, []translationMessage{{
+		ID: "po %d of %d",
+		Rules: map[string]interface{}{
+			"1": map[string]interface{}{"one": "%d of", "other": "%d of"},
+			"2": map[string]interface{}{"one": "%d file", "other": "%d files"},
+		},
+	}})
+	assert.IsType(t, &ErrorEncode{}, err)
+}
+
+func Test_EncodePOMetadata(t *testing.T) {
+	t.Parallel()
+
+	text := "Войти"
+
+	m := []translationMessage{
+		{
+			ID:          "login",
+			Message:     &text,
+			Description: "Login button.\nShown in the header.",
+			Context:     "header",
+			MaxLength:   10,
+			Hash:        "a1b2",
+		},
+	}
+
+	var out bytes.Buffer
+
+	assert.NoError(t, encodePO(&out, language.Russian, m))
+	assert.Equal(t, `msgid ""
+msgstr ""
+"Language: ru\n"
+"Content-Type: text/plain; charset=UTF-8\n"
+"Plural-Forms: nplurals=3; plural=(n % 10 == 1 && n % 100 != 11 ? 0 : n % 10 >= 2 && n % 10 <= 4 && (n % 100 < 12 || n % 100 > 14) ? 1 : 2);\n"
+
+#. Login button.
+#. Shown in the header.
+#. max length: 10
+#. hash: a1b2
+msgctxt "header"
+msgid "login"
+msgstr "Войти"
+`, out.String())
+}
+
+func Test_PluralFormsOf(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		tag        language.Tag
+		expression string
+		categories []string
+	}{
+		{tag: language.Japanese, expression: "nplurals=1; plural=0;", categories: []string{"other"}},
+		{tag: language.English, expression: "nplurals=2; plural=(n != 1);", categories: []string{"one", "other"}},
+		{tag: language.French, expression: "nplurals=2; plural=(n > 1);", categories: []string{"one", "other"}},
+		{
+			tag:        language.Czech,
+			expression: "nplurals=3; plural=(n == 1 ? 0 : n >= 2 && n <= 4 ? 1 : 2);",
+			categories: []string{"one", "few", "other"},
+		},
+		{
+			tag: language.Arabic,
+			expression: "nplurals=6; plural=(n == 0 ? 0 : n == 1 ? 1 : n == 2 ? 2 : " +
+				"n % 100 >= 3 && n % 100 <= 10 ? 3 : n % 100 >= 11 ? 4 : 5);",
+			categories: []string{"zero", "one", "two", "few", "many", "other"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.tag.String(),
+			func(t *testing.T) {
+				p, ok := pluralFormsOf(tc.tag)
+				assert.True(t, ok)
+				assert.Equal(t, tc.expression, p.expression)
+				assert.Equal(t, tc.categories, p.categories)
+
+				categories, err := pluralFormsCategories(tc.tag, p.expression)
+				assert.NoError(t, err)
+				assert.Equal(t, tc.categories, categories)
+			},
+		)
+	}
+}
+
+func Test_ParsePluralForms(t *testing.T) {
+	t.Parallel()
+
+	expression, count, err := parsePluralForms("nplurals=3; plural=n%10==1 && n%100!=11 ? 0 : !(n<2) ? 1 : 2;")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, count)
+	assert.Equal(t, []int{2, 0, 1, 1, 1, 0}, []int{expression(0), expression(1), expression(2), expression(11), expression(12), expression(21)})
+
+	for _, header := range []string{
+		"plural=n != 1;",
+		"nplurals=x; plural=n != 1;",
+		"nplurals=2;",
+		"nplurals=2; plural=(n != 1;",
+		"nplurals=2; plural=n ? 1;",
+		"nplurals=2; plural=n + ;",
+		"nplurals=2; plural=n m;",
+	} {
+		_, _, err := parsePluralForms(header)
+		assert.Error(t, err, header)
+	}
+}
+
+func Test_POLoad(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "pl.po"), []byte(testPO), 0o600))
+
+	i18n := New(&Config{Path: dir, Fallback: language.Polish})
+	assert.NoError(t, i18n.Load())
+
+	p := i18n.Printer(language.Polish)
+	assert.Equal(t, "Cześć, Ala!", p.Sprintf("po hello %v", "Ala"))
+	assert.Equal(t, "3 pliki w /tmp", p.Sprintf("po %[1]v has %[2]d files", "/tmp", 3))
+	assert.Equal(t, "brak plików w /tmp", p.Sprintf("po %[1]v has %[2]d files", "/tmp", 0))
+	assert.False(t, i18n.Has(language.Polish, "po fuzzy"))
+}
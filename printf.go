@@ -0,0 +1,66 @@
+package i18n
+
+import (
+	"regexp"
+	"strconv"
+)
+
+// Format verb of the fmt package with an optional argument index.
+var verbRegexp = regexp.MustCompile(`%([-+# 0]*)(?:\[(\d+)\])?([-+# 0]*)(\d*(?:\.\d+)?)([a-zA-Z%])`)
+
+// Format verb with its argument number.
+type verb struct {
+	// Argument number starting from 1, 0 for `%%`.
+	arg int
+	// Flags, e.g. `-`.
+	flags string
+	// Width and precision, e.g. `5.2`.
+	width string
+	// Verb character, e.g. `d`.
+	char byte
+}
+
+// Write the verb without an argument index.
+func (v verb) String() string {
+	return "%" + v.flags + v.width + string(v.char)
+}
+
+// Replace format verbs of the string. Arguments are numbered the same way
+// as the fmt package does: an explicit index resets the sequence.
+func replaceVerbs(s string, replace func(v verb) string) string {
+	return replaceVerbsText(s, func(text string) string { return text }, replace)
+}
+
+// Replace format verbs and texts between them.
+func replaceVerbsText(s string, text func(s string) string, replace func(v verb) string) string {
+	var result []byte
+
+	next, last := 1, 0
+
+	for _, sub := range verbRegexp.FindAllStringSubmatchIndex(s, -1) {
+		result = append(result, text(s[last:sub[0]])...)
+		last = sub[1]
+
+		v := verb{
+			flags: s[sub[2]:sub[3]] + s[sub[6]:sub[7]],
+			width: s[sub[8]:sub[9]],
+			char:  s[sub[10]],
+		}
+
+		if v.char == '%' {
+			result = append(result, replace(verb{char: '%'})...)
+			continue
+		}
+
+		if sub[4] >= 0 {
+			next, _ = strconv.Atoi(s[sub[4]:sub[5]])
+		}
+
+		v.arg = next
+		next++
+
+		result = append(result, replace(v)...)
+	}
+
+	return string(append(result, text(s[last:])...))
+}
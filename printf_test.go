@@ -0,0 +1,56 @@
+package i18n
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ReplaceVerbs(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		in  string
+		out string
+	}{
+		{in: "no verbs", out: "no verbs"},
+		{in: "%d of %s", out: "{1d} of {2s}"},
+		{in: "%[2]s and %s, %[1]v", out: "{2s} and {3s}, {1v}"},
+		{in: "100%% and %5.2f", out: "100{%} and {1 5.2f}"},
+		{in: "%-[2]5s and %+d", out: "{2 -5s} and {3 +d}"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.in,
+			func(t *testing.T) {
+				out := replaceVerbs(tc.in, func(v verb) string {
+					if v.arg == 0 {
+						return "{%}"
+					}
+
+					if v.flags != "" || v.width != "" {
+						return fmt.Sprintf("{%v %v%v%c}", v.arg, v.flags, v.width, v.char)
+					}
+
+					return fmt.Sprintf("{%v%c}", v.arg, v.char)
+				})
+
+				assert.Equal(t, tc.out, out)
+			},
+		)
+	}
+}
+
+func Test_ReplaceVerbsText(t *testing.T) {
+	t.Parallel()
+
+	out := replaceVerbsText(
+		"a %d b %% c",
+		func(s string) string { return "<" + s + ">" },
+		func(v verb) string { return v.String() },
+	)
+
+	assert.Equal(t, "<a >%d< b >%%< c>", out)
+}
@@ -0,0 +1,321 @@
+package i18n
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"golang.org/x/text/language"
+)
+
+// Decoder of Java `.properties` resource bundles. MessageFormat placeholders
+// `{0}` are converted to format verbs `%[1]v`, choice formats are not
+// supported. A comment before a key is used as the message description.
+//
+// The language tag is taken from the file name suffix, e.g.
+// `messages_pt_BR.properties`, so files may be placed in the languages
+// folder root.
+type propertiesDecoder struct{}
+
+// Decode translation messages.
+func (d propertiesDecoder) Decode(data []byte) ([]Message, error) {
+	return publicMessages(d.decode(data))
+}
+
+// Decode translation messages.
+func (propertiesDecoder) decode(data []byte) ([]translationMessage, error) {
+	// Files are ISO 8859-1 before Java 9.
+	if !utf8.Valid(data) {
+		runes := make([]rune, len(data))
+		for n, b := range data {
+			runes[n] = rune(b)
+		}
+
+		data = []byte(string(runes))
+	}
+
+	var result []translationMessage
+	var comments []string
+
+	lines := strings.Split(strings.ReplaceAll(string(data), "\r\n", "\n"), "\n")
+
+	for n := 0; n < len(lines); n++ {
+		number := n + 1
+		line := strings.TrimLeft(lines[n], " \t\f")
+
+		switch {
+		case line == "":
+			comments = nil
+			continue
+		case line[0] == '#' || line[0] == '!':
+			comments = append(comments, strings.TrimSpace(line[1:]))
+			continue
+		}
+
+		// Join continuation lines.
+		for continues(line) && n+1 < len(lines) {
+			n++
+			line = line[:len(line)-1] + strings.TrimLeft(lines[n], " \t\f")
+		}
+
+		key, value := splitProperty(line)
+
+		text, err := messageFormatToPrintf(unescapeProperty(value))
+		if err != nil {
+			return nil, &ErrorDecode{
+				Format:  "properties",
+				Message: fmt.Sprintf("line %v: %v", number, err),
+			}
+		}
+
+		result = append(result, translationMessage{
+			ID:          unescapeProperty(key),
+			Message:     &text,
+			Description: strings.Join(comments, "\n"),
+		})
+
+		comments = nil
+	}
+
+	return result, nil
+}
+
+// Get the language tag from the file name suffix.
+func (propertiesDecoder) tagFromName(name string) (language.Tag, bool) {
+	return suffixTag(name)
+}
+
+// Check if the line ends with an odd number of backslashes.
+func continues(line string) bool {
+	n := len(line) - len(strings.TrimRight(line, `\`))
+
+	return n%2 == 1
+}
+
+// Split a property line to a key and a value. The key ends with the first
+// unescaped `=`, `:` or white space.
+func splitProperty(line string) (string, string) {
+	for n := 0; n < len(line); n++ {
+		switch line[n] {
+		case '\\':
+			n++
+		case '=', ':':
+			return line[:n], strings.TrimLeft(line[n+1:], " \t\f")
+		case ' ', '\t', '\f':
+			value := strings.TrimLeft(line[n:], " \t\f")
+			if value != "" && (value[0] == '=' || value[0] == ':') {
+				value = strings.TrimLeft(value[1:], " \t\f")
+			}
+
+			return line[:n], value
+		}
+	}
+
+	return line, ""
+}
+
+// Unescape a property key or value.
+func unescapeProperty(s string) string {
+	var b strings.Builder
+
+	for n := 0; n < len(s); n++ {
+		if s[n] != '\\' || n+1 == len(s) {
+			b.WriteByte(s[n])
+			continue
+		}
+
+		n++
+
+		switch s[n] {
+		case 't':
+			b.WriteByte('\t')
+		case 'n':
+			b.WriteByte('\n')
+		case 'r':
+			b.WriteByte('\r')
+		case 'f':
+			b.WriteByte('\f')
+		case 'u':
+			r, ok := propertyRune(s, n)
+			if !ok {
+				b.WriteByte('u')
+				continue
+			}
+
+			n += 4
+
+			// Characters outside of BMP are escaped as surrogate pairs.
+			if low, ok := propertyRune(s, n+2); ok && utf16.IsSurrogate(r) && s[n+1] == '\\' {
+				r = utf16.DecodeRune(r, low)
+				n += 6
+			}
+
+			b.WriteRune(r)
+		default:
+			b.WriteByte(s[n])
+		}
+	}
+
+	return b.String()
+}
+
+// Parse a `uXXXX` code unit at the position.
+func propertyRune(s string, n int) (rune, bool) {
+	if n+5 > len(s) || s[n] != 'u' {
+		return 0, false
+	}
+
+	r, err := strconv.ParseUint(s[n+1:n+5], 16, 16)
+
+	return rune(r), err == nil
+}
+
+// Convert a Java MessageFormat pattern to a format string. A text without
+// placeholders isn't a pattern, so quotes are kept as is.
+func messageFormatToPrintf(s string) (string, error) {
+	if !strings.Contains(s, "{") {
+		return strings.ReplaceAll(s, "%", "%%"), nil
+	}
+
+	var b strings.Builder
+
+	for n := 0; n < len(s); n++ {
+		switch s[n] {
+		case '%':
+			b.WriteString("%%")
+		case '\'':
+			// Two quotes are a quote, otherwise a quoted text is literal.
+			if n+1 < len(s) && s[n+1] == '\'' {
+				b.WriteByte('\'')
+				n++
+
+				continue
+			}
+
+			end := strings.IndexByte(s[n+1:], '\'')
+			if end < 0 {
+				end = len(s) - n - 1
+			}
+
+			b.WriteString(strings.ReplaceAll(s[n+1:n+1+end], "%", "%%"))
+			n += end + 1
+		case '{':
+			end, err := matchBrace(s, n)
+			if err != nil {
+				return "", err
+			}
+
+			verb, err := messageFormatVerb(s[n+1 : end])
+			if err != nil {
+				return "", err
+			}
+
+			b.WriteString(verb)
+			n = end
+		default:
+			b.WriteByte(s[n])
+		}
+	}
+
+	return b.String(), nil
+}
+
+// Convert a MessageFormat argument without braces to a format verb.
+func messageFormatVerb(s string) (string, error) {
+	fields := strings.SplitN(s, ",", 3)
+
+	arg, err := strconv.Atoi(strings.TrimSpace(fields[0]))
+	if err != nil || arg < 0 {
+		return "", fmt.Errorf("wrong argument `{%v}`", s)
+	}
+
+	char := 'v'
+
+	if len(fields) > 1 {
+		switch kind := strings.TrimSpace(fields[1]); kind {
+		case "number":
+			if len(fields) > 2 && strings.TrimSpace(fields[2]) == "integer" {
+				char = 'd'
+			}
+		case "date", "time":
+		default:
+			return "", fmt.Errorf("`%v` format is not supported", kind)
+		}
+	}
+
+	return fmt.Sprintf("%%[%v]%c", arg+1, char), nil
+}
+
+// Get the language tag from a file name suffix like Java and Qt do, e.g.
+// `messages_pt_BR.properties` or `app_sr_Latn_RS.ts`. The suffix is a lower
+// case language code followed by an optional title case script and an upper
+// case region.
+func suffixTag(name string) (language.Tag, bool) {
+	_, tag, ok := splitSuffixTag(name)
+
+	return tag, ok
+}
+
+// Split a file name into the base name and the language tag of its suffix,
+// e.g. `messages` and `pt-BR` of `messages_pt_BR.properties`.
+func splitSuffixTag(name string) (string, language.Tag, bool) {
+	parts := strings.Split(strings.TrimSuffix(name, filepath.Ext(name)), "_")
+
+	for n := len(parts) - 1; n > 0; n-- {
+		if !isLanguageCode(parts[n]) {
+			continue
+		}
+
+		ok := true
+
+		for k, part := range parts[n+1:] {
+			ok = ok && (isRegionCode(part) || k == 0 && isScriptCode(part))
+		}
+
+		if !ok {
+			continue
+		}
+
+		tag, err := language.Parse(strings.Join(parts[n:], "-"))
+		if err == nil {
+			return strings.Join(parts[:n], "_"), tag, true
+		}
+	}
+
+	return "", language.Und, false
+}
+
+// Check if the string is a lower case language code.
+func isLanguageCode(s string) bool {
+	return (len(s) == 2 || len(s) == 3) && strings.ToLower(s) == s && isLetters(s)
+}
+
+// Check if the string is a title case script code.
+func isScriptCode(s string) bool {
+	return len(s) == 4 && isLetters(s) && s[:1] == strings.ToUpper(s[:1]) && s[1:] == strings.ToLower(s[1:])
+}
+
+// Check if the string is an upper case region code or a numeric area code.
+func isRegionCode(s string) bool {
+	if len(s) == 3 {
+		_, err := strconv.Atoi(s)
+
+		return err == nil
+	}
+
+	return len(s) == 2 && strings.ToUpper(s) == s && isLetters(s)
+}
+
+// Check if the string consists of ASCII letters.
+func isLetters(s string) bool {
+	for n := 0; n < len(s); n++ {
+		if c := s[n] | 0x20; c < 'a' || c > 'z' {
+			return false
+		}
+	}
+
+	return true
+}
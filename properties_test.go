@@ -0,0 +1,153 @@
+package i18n
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+)
+
+func Test_PropertiesDecode(t *testing.T) {
+	t.Parallel()
+
+	text := func(s string) *string { return &s }
+
+	testCases := []struct {
+		name string
+		data string
+		out  []translationMessage
+		err  bool
+	}{
+		{
+			name: "entries",
+			data: `# Greeting
+! shown on the main page
+hello = Hello, {0}! You have {1,number,integer} messages
+
+auth.login:Log in
+auth.logout   Log out
+escaped\ key\=1 = Tab\there, Привет 😀
+multiline = first, \
+            second
+quotes = It''s {0}, '{literal}' 100%
+plain = Don't worry, 100%
+empty =
+`,
+			out: []translationMessage{
+				{
+					ID:          "hello",
+					Message:     text("Hello, %[1]v! You have %[2]d messages"),
+					Description: "Greeting\nshown on the main page",
+				},
+				{ID: "auth.login", Message: text("Log in")},
+				{ID: "auth.logout", Message: text("Log out")},
+				{ID: "escaped key=1", Message: text("Tab\there, Привет 😀")},
+				{ID: "multiline", Message: text("first, second")},
+				{ID: "quotes", Message: text("It's %[1]v, {literal} 100%%")},
+				{ID: "plain", Message: text("Don't worry, 100%%")},
+				{ID: "empty", Message: text("")},
+			},
+		},
+		{
+			name: "latin-1",
+			data: "hello = caf\xe9",
+			out:  []translationMessage{{ID: "hello", Message: text("café")}},
+		},
+		{
+			name: "choice is not supported",
+			data: "files = {0,choice,0#no files|1#one file|1<{0} files}",
+			err:  true,
+		},
+		{
+			name: "unclosed brace",
+			data: "hello = Hello, {0",
+			err:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name,
+			func(t *testing.T) {
+				m, err := propertiesDecoder{}.decode([]byte(tc.data))
+
+				if tc.err {
+					assert.Error(t, err)
+				} else {
+					assert.NoError(t, err)
+					assert.Equal(t, tc.out, m)
+				}
+			},
+		)
+	}
+}
+
+func Test_SuffixTag(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+		tag  language.Tag
+		ok   bool
+	}{
+		{name: "messages_ru.properties", tag: language.Russian, ok: true},
+		{name: "messages_pt_BR.properties", tag: language.BrazilianPortuguese, ok: true},
+		{name: "my_app_sr_Latn_RS.ts", tag: language.MustParse("sr-Latn-RS"), ok: true},
+		{name: "app_es_419.ts", tag: language.MustParse("es-419"), ok: true},
+		{name: "my_ru.properties", tag: language.Russian, ok: true},
+		{name: "messages.properties"},
+		{name: "messages_RU.properties"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name,
+			func(t *testing.T) {
+				tag, ok := suffixTag(tc.name)
+
+				assert.Equal(t, tc.ok, ok)
+				assert.Equal(t, tc.tag, tag)
+			},
+		)
+	}
+}
+
+func Test_PropertiesLoad(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	files := map[string]string{
+		"messages.properties":       "properties.hello = Hello",
+		"messages_en.properties":    "properties.hello = Hello, {0}",
+		"messages_pt_BR.properties": "properties.hello = Olá, {0}",
+		"errors_pt_BR.properties":   "properties.error = Erro",
+	}
+
+	for name, data := range files {
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(data), 0o600))
+	}
+
+	var skipped []string
+
+	i18n := New(&Config{
+		Path: dir,
+		Debug: func(format string, v ...interface{}) {
+			skipped = append(skipped, filepath.Base(v[0].(string)))
+		},
+	})
+	assert.NoError(t, i18n.Load())
+
+	assert.Equal(t, []language.Tag{language.English, language.BrazilianPortuguese}, i18n.Tags())
+	assert.Equal(t, []string{"messages.properties"}, skipped)
+	assert.Equal(t, "Olá, Ana", i18n.Printer(language.BrazilianPortuguese).Sprintf("properties.hello", "Ana"))
+	assert.Equal(t, "Erro", i18n.Printer(language.BrazilianPortuguese).Sprintf("properties.error"))
+
+	domains := New(&Config{Path: dir, Domain: DomainFromPath})
+	assert.NoError(t, domains.Load())
+
+	assert.Equal(t, []string{"errors", "messages"}, domains.Domains())
+	assert.Equal(t, []string{"properties.error"}, domains.Domain("errors").IDs(language.BrazilianPortuguese))
+}
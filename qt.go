@@ -0,0 +1,191 @@
+package i18n
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// Decoder of Qt Linguist `.ts` files. A message id is the `id` attribute or
+// the Qt context and the source text joined with `|`, e.g. `MainWindow|Open`,
+// the Qt context is also kept as the message context. Obsolete and empty
+// translations are skipped.
+//
+// Placeholders `%1` are converted to format verbs `%[1]v`. Numerus forms
+// are converted to plural rules in the order of plural categories of the
+// file language, the last form is also used for `other`. A plural message
+// gets the count `%n` as the first argument, so `%1` becomes `%[2]v`.
+//
+// The language tag is taken from the file name suffix, e.g. `app_ru.ts`, so
+// files may be placed in the languages folder root. Other `.ts` files, e.g.
+// TypeScript sources, are skipped.
+type qtDecoder struct{}
+
+// Qt Linguist file.
+type qtTS struct {
+	Language string      `xml:"language,attr"`
+	Contexts []qtContext `xml:"context"`
+}
+
+// Qt context.
+type qtContext struct {
+	Name     string      `xml:"name"`
+	Messages []qtMessage `xml:"message"`
+}
+
+// Qt message.
+type qtMessage struct {
+	ID           string        `xml:"id,attr"`
+	Numerus      string        `xml:"numerus,attr"`
+	Source       string        `xml:"source"`
+	Comment      string        `xml:"comment"`
+	ExtraComment string        `xml:"extracomment"`
+	Translation  qtTranslation `xml:"translation"`
+}
+
+// Qt translation.
+type qtTranslation struct {
+	Type         string   `xml:"type,attr"`
+	Text         string   `xml:",chardata"`
+	NumerusForms []string `xml:"numerusform"`
+}
+
+// Decode translation messages.
+func (d qtDecoder) Decode(data []byte) ([]Message, error) {
+	return publicMessages(d.decode(data))
+}
+
+// Decode translation messages.
+func (qtDecoder) decode(data []byte) ([]translationMessage, error) {
+	var ts qtTS
+	if err := xml.Unmarshal(data, &ts); err != nil {
+		return nil, err
+	}
+
+	var categories []string
+	var result []translationMessage
+
+	for _, context := range ts.Contexts {
+		for _, message := range context.Messages {
+			translation := message.Translation
+			if translation.Type == "obsolete" || translation.Type == "vanished" {
+				continue
+			}
+
+			m := translationMessage{
+				ID:          message.ID,
+				Description: strings.TrimSpace(message.ExtraComment + "\n" + message.Comment),
+				Context:     context.Name,
+			}
+
+			if m.ID == "" {
+				m.ID = qtID(context.Name, message.Source)
+			}
+
+			if message.Numerus != "yes" {
+				if translation.Text == "" {
+					continue
+				}
+
+				text := qtToPrintf(translation.Text, 0)
+				m.Message = &text
+				result = append(result, m)
+
+				continue
+			}
+
+			if len(translation.NumerusForms) == 0 || translation.NumerusForms[0] == "" {
+				continue
+			}
+
+			if categories == nil {
+				tag, err := language.Parse(ts.Language)
+				if err != nil {
+					return nil, &ErrorDecode{Format: "ts", Message: "language is required for numerus forms"}
+				}
+
+				categories = pluralCategories(tag)
+			}
+
+			m.Rules = qtRules(translation.NumerusForms, categories)
+			result = append(result, m)
+		}
+	}
+
+	sort.SliceStable(result, func(a, b int) bool { return result[a].ID < result[b].ID })
+
+	return result, nil
+}
+
+// Get a message id of the source text in the Qt context.
+func qtID(context, source string) string {
+	if context == "" {
+		return source
+	}
+
+	return context + "|" + source
+}
+
+// Check if the data is a Qt Linguist file.
+func (qtDecoder) detect(data []byte) bool {
+	d := xml.NewDecoder(bytes.NewReader(data))
+
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return false
+		}
+
+		if start, ok := token.(xml.StartElement); ok {
+			return start.Name.Local == "TS"
+		}
+	}
+}
+
+// Get the language tag from the file name suffix.
+func (qtDecoder) tagFromName(name string) (language.Tag, bool) {
+	return suffixTag(name)
+}
+
+// Convert numerus forms to plural rules.
+func qtRules(forms []string, categories []string) map[string]interface{} {
+	rules := make(map[string]interface{}, len(categories))
+
+	for n, form := range forms {
+		if n < len(categories) {
+			rules[categories[n]] = qtToPrintf(form, 1)
+		}
+	}
+
+	rules["other"] = qtToPrintf(forms[len(forms)-1], 1)
+
+	return rules
+}
+
+// Qt placeholder: `%n`, `%1` or localized `%L1`.
+var qtVerbRegexp = regexp.MustCompile(`%L?(n|\d{1,2})|%`)
+
+// Convert a Qt text to a format string. Argument numbers are shifted by
+// offset, the count `%n` is the first argument.
+func qtToPrintf(s string, offset int) string {
+	return qtVerbRegexp.ReplaceAllStringFunc(s, func(match string) string {
+		sub := qtVerbRegexp.FindStringSubmatch(match)
+
+		switch sub[1] {
+		case "":
+			return "%%"
+		case "n":
+			return "%[1]d"
+		}
+
+		arg, _ := strconv.Atoi(sub[1])
+
+		return fmt.Sprintf("%%[%v]v", arg+offset)
+	})
+}
@@ -0,0 +1,109 @@
+package i18n
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+)
+
+// Qt Linguist file with Russian translations.
+const testTS = `<?xml version="1.0" encoding="utf-8"?>
+<!DOCTYPE TS>
+<TS version="2.1" language="ru_RU" sourcelanguage="en">
+<context>
+    <name>MainWindow</name>
+    <message>
+        <location filename="mainwindow.cpp" line="10"/>
+        <source>qt hello %1</source>
+        <extracomment>Greeting</extracomment>
+        <translation>Привет, %1! Скидка 10%</translation>
+    </message>
+    <message numerus="yes">
+        <source>qt %n file(s) in %1</source>
+        <translation>
+            <numerusform>%n файл в %1</numerusform>
+            <numerusform>%n файла в %1</numerusform>
+            <numerusform>%n файлов в %1</numerusform>
+        </translation>
+    </message>
+    <message id="qt.unfinished">
+        <source>Unfinished</source>
+        <translation type="unfinished"></translation>
+    </message>
+    <message>
+        <source>Old</source>
+        <translation type="vanished">Старое</translation>
+    </message>
+</context>
+</TS>
+`
+
+func Test_QtDecode(t *testing.T) {
+	t.Parallel()
+
+	text := func(s string) *string { return &s }
+
+	m, err := qtDecoder{}.decode([]byte(testTS))
+	assert.NoError(t, err)
+
+	assert.Equal(t, []translationMessage{
+		{
+			ID: "MainWindow|qt %n file(s) in %1",
+			Rules: map[string]interface{}{
+				"one":   "%[1]d файл в %[2]v",
+				"few":   "%[1]d файла в %[2]v",
+				"many":  "%[1]d файлов в %[2]v",
+				"other": "%[1]d файлов в %[2]v",
+			},
+			Context: "MainWindow",
+		},
+		{
+			ID:          "MainWindow|qt hello %1",
+			Message:     text("Привет, %[1]v! Скидка 10%%"),
+			Description: "Greeting",
+			Context:     "MainWindow",
+		},
+	}, m)
+
+	_, err = qtDecoder{}.decode([]byte(`<TS><context><name>Main</name><message numerus="yes">
+    <source>%n files</source><translation><numerusform>%n file</numerusform></translation>
+  </message></context></TS>`))
+	assert.Error(t, err)
+
+	m, err = qtDecoder{}.decode([]byte(`<TS>
+  <context><name>File</name><message><source>Open</source><translation>Открыть</translation></message></context>
+  <context><name>Door</name><message><source>Open</source><translation>Открыта</translation></message></context>
+  <context><name>Door</name><message id="door.close"><source>Close</source><translation>Закрыть</translation></message></context>
+</TS>`))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Door|Open", "File|Open", "door.close"}, []string{m[0].ID, m[1].ID, m[2].ID})
+	assert.Equal(t, "Открыта", *m[0].Message)
+}
+
+func Test_QtDetect(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, qtDecoder{}.detect([]byte(testTS)))
+	assert.False(t, qtDecoder{}.detect([]byte("export const ru = { hello: 'Привет' }\n")))
+	assert.False(t, qtDecoder{}.detect([]byte(`<resources></resources>`)))
+}
+
+func Test_QtLoad(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "app_ru.ts"), []byte(testTS), 0o600))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "strings_ru.ts"), []byte("export default {}\n"), 0o600))
+	assert.NoError(t, os.Mkdir(filepath.Join(dir, "en"), 0o700))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "en", "index.ts"), []byte("export default {}\n"), 0o600))
+
+	i18n := New(&Config{Path: dir})
+	assert.NoError(t, i18n.Load())
+
+	p := i18n.Printer(language.Russian)
+	assert.Equal(t, "5 файлов в Documents", p.Sprintf("MainWindow|qt %n file(s) in %1", 5, "Documents"))
+	assert.Equal(t, "2 файла в Documents", p.Sprintf("MainWindow|qt %n file(s) in %1", 2, "Documents"))
+}
@@ -0,0 +1,249 @@
+package i18n
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/message/catalog"
+)
+
+// Source of translation messages layered on top of translation files, e.g.
+// a database with overrides edited in an admin UI.
+type Source interface {
+	// Load messages grouped by language.
+	Load(ctx context.Context) (map[language.Tag][]Message, error)
+}
+
+// LoadSources loads messages of Config.Sources and merges them into the
+// catalog. Translation files have the lowest precedence, sources are applied
+// in order, so a message of a later source replaces a message with the same
+// id of files and earlier sources. A message with Message.Domain overrides
+// a message of the domain.
+//
+// It's called by Load and may be called again at runtime to refresh
+// overrides. A message removed from sources is restored from translation
+// files, a message which exists only in sources is removed. Loaded messages
+// don't change if an error is returned.
+func (i *I18n) LoadSources(ctx context.Context) error {
+	messages := make(sourceMessages)
+
+	for _, source := range i.config.Sources {
+		loaded, err := source.Load(ctx)
+		if err != nil {
+			return err
+		}
+
+		for tag, m := range loaded {
+			// Messages are compiled in a scratch catalog, so an error doesn't
+			// change loaded messages.
+			tr := &translation{
+				tag:      tag,
+				filePath: fmt.Sprintf("%T", source),
+				domain:   &domain{catalog: catalog.NewBuilder()},
+			}
+
+			for _, message := range m {
+				m := newTranslationMessage(message)
+
+				if err = tr.validateMessage(m); err != nil {
+					return err
+				}
+
+				if err = tr.loadMessage(m); err != nil {
+					return err
+				}
+
+				messages.add(message.Domain, tag, m)
+			}
+		}
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	removed, err := i.restore(messages)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range sortedDomains(messages) {
+		for _, tag := range sortedTags(messages[name]) {
+			if _, ok := i.printer[tag]; !ok {
+				i.printer[tag] = message.NewPrinter(tag)
+			}
+
+			tr := i.sourceTranslation(name, tag)
+
+			for _, id := range sortedIDs(messages[name][tag]) {
+				if err := tr.loadMessages([]translationMessage{messages[name][tag][id]}); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	// Removed messages are cleared when messages of parent languages are
+	// final.
+	for _, m := range removed {
+		if err := i.sourceTranslation(m.domain, m.tag).clear(m.id); err != nil {
+			return err
+		}
+	}
+
+	i.overrides = messages
+
+	return nil
+}
+
+// Messages of sources by domain, language and id.
+type sourceMessages map[string]map[language.Tag]map[string]translationMessage
+
+// Add a message of the domain, the message replaces a message with the same
+// id added before.
+func (s sourceMessages) add(domain string, tag language.Tag, m translationMessage) {
+	if _, ok := s[domain]; !ok {
+		s[domain] = make(map[language.Tag]map[string]translationMessage)
+	}
+
+	if _, ok := s[domain][tag]; !ok {
+		s[domain][tag] = make(map[string]translationMessage)
+	}
+
+	s[domain][tag][m.ID] = m
+}
+
+// Message removed from sources.
+type removedMessage struct {
+	domain string
+	tag    language.Tag
+	id     string
+}
+
+// Restore messages of translation files overridden by sources before and
+// missing in the new overrides. Messages which exist only in sources are
+// removed from the index and returned.
+func (i *I18n) restore(overrides sourceMessages) ([]removedMessage, error) {
+	var removed []removedMessage
+
+	for name, tags := range i.overrides {
+		for tag, messages := range tags {
+			tr := i.sourceTranslation(name, tag)
+
+			files := i.files
+			if tr.domain != nil {
+				files = tr.domain.files
+			}
+
+			for id := range messages {
+				if _, ok := overrides[name][tag][id]; ok {
+					continue
+				}
+
+				m, ok := files.lookup(tag, id)
+				if !ok {
+					tr.index.remove(tag, id)
+					removed = append(removed, removedMessage{domain: name, tag: tag, id: id})
+
+					continue
+				}
+
+				if err := tr.loadMessages([]translationMessage{m}); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	return removed, nil
+}
+
+// Clear a removed message in the catalog. The catalog has no way to delete a
+// message, so the message of a parent language is set if it's loaded, or the
+// id as the printer formats a missing message.
+func (i *translation) clear(id string) error {
+	for tag := i.tag.Parent(); ; tag = tag.Parent() {
+		if m, ok := i.index.lookup(tag, id); ok {
+			return i.loadMessage(m)
+		}
+
+		if tag == language.Und {
+			break
+		}
+	}
+
+	return i.set(id, catalog.String(id))
+}
+
+// Create a translation of source messages of the domain, messages without
+// a domain are loaded into the default catalog. The lock must be held.
+func (i *I18n) sourceTranslation(name string, tag language.Tag) *translation {
+	tr := &translation{tag: tag, filePath: "source", index: i.index}
+	if name != "" {
+		tr.domain = i.domain(name)
+		tr.index = tr.domain.index
+	}
+
+	return tr
+}
+
+// Convert a public message to the translation message.
+func newTranslationMessage(m Message) translationMessage {
+	result := translationMessage{
+		ID:          m.ID,
+		Description: m.Description,
+		Context:     m.Context,
+		MaxLength:   m.MaxLength,
+		Hash:        m.Hash,
+	}
+
+	// Both fields are kept to report an error while validating.
+	if m.Rules != nil {
+		result.Rules = m.Rules
+	}
+
+	if m.Rules == nil || m.Message != "" {
+		text := m.Message
+		result.Message = &text
+	}
+
+	return result
+}
+
+// Get sorted language tags of messages.
+func sortedTags(messages map[language.Tag]map[string]translationMessage) []language.Tag {
+	tags := make([]language.Tag, 0, len(messages))
+	for tag := range messages {
+		tags = append(tags, tag)
+	}
+
+	sort.Slice(tags, func(a, b int) bool { return tags[a].String() < tags[b].String() })
+
+	return tags
+}
+
+// Get sorted domain names of messages.
+func sortedDomains(messages sourceMessages) []string {
+	names := make([]string, 0, len(messages))
+	for name := range messages {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// Get sorted message ids.
+func sortedIDs(messages map[string]translationMessage) []string {
+	ids := make([]string, 0, len(messages))
+	for id := range messages {
+		ids = append(ids, id)
+	}
+
+	sort.Strings(ids)
+
+	return ids
+}
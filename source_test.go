@@ -0,0 +1,189 @@
+package i18n
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+// Source with static messages.
+type fakeSource struct {
+	messages map[language.Tag][]Message
+	err      error
+}
+
+// Load messages grouped by language.
+func (i *fakeSource) Load(context.Context) (map[language.Tag][]Message, error) {
+	return i.messages, i.err
+}
+
+func Test_I18nLoadSources(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		sources []Source
+		out     string
+		err     bool
+	}{
+		{
+			name: "later source goes first",
+			sources: []Source{
+				&fakeSource{messages: map[language.Tag][]Message{
+					language.Dutch: {{ID: "source precedence", Message: "first"}},
+				}},
+				&fakeSource{messages: map[language.Tag][]Message{
+					language.Dutch: {{ID: "source precedence", Message: "second"}},
+				}},
+			},
+			out: "second",
+		},
+		{
+			name: "source error",
+			sources: []Source{
+				&fakeSource{err: errors.New("source error")},
+			},
+			err: true,
+		},
+		{
+			name: "validation error",
+			sources: []Source{
+				&fakeSource{messages: map[language.Tag][]Message{
+					language.Dutch: {{Message: "without id"}},
+				}},
+			},
+			err: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name,
+			func(t *testing.T) {
+				i18n := New(&Config{Sources: tc.sources})
+
+				err := i18n.LoadSources(context.Background())
+
+				if tc.err {
+					assert.Error(t, err)
+					assert.Empty(t, i18n.Tags())
+				} else {
+					assert.NoError(t, err)
+					assert.Equal(t, tc.out, i18n.Printer(language.Dutch).Sprintf("source precedence"))
+				}
+			},
+		)
+	}
+}
+
+func Test_I18nLoadSourcesRestore(t *testing.T) {
+	t.Parallel()
+
+	denmark := language.MustParse("da-DK")
+
+	source := &fakeSource{messages: map[language.Tag][]Message{
+		language.Danish: {
+			{ID: "source restore", Message: "override"},
+			{ID: "source restore only %d", Message: "only %d"},
+		},
+		denmark: {{ID: "source restore", Message: "region"}},
+	}}
+
+	i18n := New(&Config{Sources: []Source{source}})
+	i18n.printer[language.Danish] = message.NewPrinter(language.Danish)
+
+	tr := &translation{tag: language.Danish, index: i18n.index}
+	assert.NoError(t, tr.append([]byte(`{"source restore": "file"}`)))
+
+	i18n.files = i18n.index.clone()
+
+	assert.NoError(t, i18n.LoadSources(context.Background()))
+	assert.Equal(t, "override", i18n.Printer(language.Danish).Sprintf("source restore"))
+	assert.Equal(t, "only 1", i18n.Printer(language.Danish).Sprintf("source restore only %d", 1))
+	assert.Equal(t, "region", i18n.Printer(denmark).Sprintf("source restore"))
+
+	source.messages = nil
+
+	assert.NoError(t, i18n.LoadSources(context.Background()))
+	assert.Equal(t, "file", i18n.Printer(language.Danish).Sprintf("source restore"))
+	assert.Equal(t, "source restore only 1", i18n.Printer(language.Danish).Sprintf("source restore only %d", 1))
+	assert.False(t, i18n.Has(language.Danish, "source restore only %d"))
+	assert.Equal(t, "file", i18n.Printer(denmark).Sprintf("source restore"))
+	assert.False(t, i18n.Has(denmark, "source restore"))
+}
+
+func Test_I18nLoadSourcesError(t *testing.T) {
+	t.Parallel()
+
+	source := &fakeSource{messages: map[language.Tag][]Message{
+		language.Swedish: {{ID: "source error", Message: "A1"}},
+	}}
+
+	i18n := New(&Config{Sources: []Source{source}})
+	i18n.printer[language.Swedish] = message.NewPrinter(language.Swedish)
+
+	tr := &translation{tag: language.Swedish, index: i18n.index}
+	assert.NoError(t, tr.append([]byte(`{"source error": "A0"}`)))
+
+	i18n.files = i18n.index.clone()
+
+	assert.NoError(t, i18n.LoadSources(context.Background()))
+	assert.Equal(t, "A1", i18n.Printer(language.Swedish).Sprintf("source error"))
+
+	for _, rules := range []map[string]interface{}{
+		{"x": map[string]interface{}{"one": "b"}},
+		{"one": nil, "other": "b"},
+		{"1": map[string]interface{}{"one": nil}},
+	} {
+		source.messages = map[language.Tag][]Message{
+			language.Swedish: {{ID: "source error %d", Rules: rules}},
+		}
+
+		assert.Error(t, i18n.LoadSources(context.Background()))
+		assert.Equal(t, "A1", i18n.Printer(language.Swedish).Sprintf("source error"))
+		assert.False(t, i18n.Has(language.Swedish, "source error %d"))
+	}
+}
+
+func Test_I18nLoadSourcesDomain(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	assert.NoError(t, os.MkdirAll(filepath.Join(dir, "en"), 0o755))
+	assert.NoError(t, os.WriteFile(
+		filepath.Join(dir, "en", "auth.json"),
+		[]byte(`{"source domain title": "Sign in"}`),
+		0o600,
+	))
+
+	source := &fakeSource{messages: map[language.Tag][]Message{
+		language.English: {
+			{ID: "source domain title", Message: "Log in", Domain: "auth"},
+			{ID: "source domain title", Message: "Title"},
+		},
+	}}
+
+	i18n := New(&Config{Path: dir, Fallback: language.English, Domain: DomainFromPath, Sources: []Source{source}})
+	assert.NoError(t, i18n.Load())
+
+	auth := i18n.Domain("auth")
+
+	assert.Equal(t, "Log in", auth.Printer(language.English).Sprintf("source domain title"))
+	assert.Equal(t, "Title", i18n.Printer(language.English).Sprintf("source domain title"))
+
+	m, ok := auth.Message(language.English, "source domain title")
+	assert.True(t, ok)
+	assert.Equal(t, Message{ID: "source domain title", Message: "Log in", Domain: "auth"}, m)
+
+	source.messages = nil
+
+	assert.NoError(t, i18n.LoadSources(context.Background()))
+	assert.Equal(t, "Sign in", auth.Printer(language.English).Sprintf("source domain title"))
+	assert.False(t, i18n.Has(language.English, "source domain title"))
+}
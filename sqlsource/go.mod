@@ -0,0 +1,19 @@
+module github.com/yarigo/i18n/v2/sqlsource
+
+go 1.18
+
+require (
+	github.com/mattn/go-sqlite3 v1.14.17
+	github.com/stretchr/testify v1.7.1
+	github.com/yarigo/i18n/v2 v2.0.0-20261019001344-1d2b512a7ed2
+	golang.org/x/text v0.9.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.0 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+// The module is developed with the i18n module of the repository.
+replace github.com/yarigo/i18n/v2 => ../
@@ -0,0 +1,81 @@
+// Package sqlsource is a reference i18n.Source implementation loading
+// messages from an SQL table.
+package sqlsource
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/yarigo/i18n/v2"
+	"golang.org/x/text/language"
+)
+
+// Schema of the messages table. `rules` is a JSON object with plural rules,
+// only one of `message` or `rules` should be set. A row without both isn't
+// translated yet and is skipped.
+const Schema = `CREATE TABLE IF NOT EXISTS %v (
+	tag         VARCHAR(35)  NOT NULL,
+	id          VARCHAR(255) NOT NULL,
+	message     TEXT,
+	rules       TEXT,
+	description TEXT,
+	PRIMARY KEY (tag, id)
+)`
+
+// Source loads messages from an SQL table.
+type Source struct {
+	db    *sql.DB
+	table string
+}
+
+// New creates a source reading the table, see Schema. The table name is
+// not escaped, it must be trusted.
+func New(db *sql.DB, table string) *Source {
+	return &Source{db: db, table: table}
+}
+
+// Load messages grouped by language.
+func (s *Source) Load(ctx context.Context) (map[language.Tag][]i18n.Message, error) {
+	rows, err := s.db.QueryContext(ctx, fmt.Sprintf(
+		"SELECT tag, id, message, rules, description FROM %v ORDER BY tag, id",
+		s.table,
+	))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make(map[language.Tag][]i18n.Message)
+
+	for rows.Next() {
+		var name, id string
+		var text, rules, description sql.NullString
+
+		if err = rows.Scan(&name, &id, &text, &rules, &description); err != nil {
+			return nil, err
+		}
+
+		if !text.Valid && !rules.Valid {
+			continue
+		}
+
+		tag, err := language.Parse(name)
+		if err != nil {
+			return nil, err
+		}
+
+		m := i18n.Message{ID: id, Message: text.String, Description: description.String}
+
+		if rules.Valid {
+			if err = json.Unmarshal([]byte(rules.String), &m.Rules); err != nil {
+				return nil, err
+			}
+		}
+
+		result[tag] = append(result[tag], m)
+	}
+
+	return result, rows.Err()
+}
@@ -0,0 +1,130 @@
+package sqlsource
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/yarigo/i18n/v2"
+	"golang.org/x/text/language"
+)
+
+// Open a database with the messages table.
+func open(t *testing.T) *sql.DB {
+	db, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), "i18n.db"))
+	assert.NoError(t, err)
+
+	t.Cleanup(func() { db.Close() })
+
+	_, err = db.Exec(fmt.Sprintf(Schema, "messages"))
+	assert.NoError(t, err)
+
+	return db
+}
+
+func Test_SourceLoad(t *testing.T) {
+	t.Parallel()
+
+	db := open(t)
+
+	_, err := db.Exec(`INSERT INTO messages (tag, id, message, rules, description) VALUES
+		('en', 'sql hello', 'Hello', NULL, 'Greeting'),
+		('ru', 'sql hello', 'Привет', NULL, NULL),
+		('ru', 'sql apples', NULL, '{"one": "%d яблоко", "other": "%d яблок"}', NULL),
+		('ru', 'sql untranslated', NULL, NULL, 'Not translated yet')`)
+	assert.NoError(t, err)
+
+	messages, err := New(db, "messages").Load(context.Background())
+	assert.NoError(t, err)
+
+	assert.Equal(t, map[language.Tag][]i18n.Message{
+		language.English: {
+			{ID: "sql hello", Message: "Hello", Description: "Greeting"},
+		},
+		language.Russian: {
+			{ID: "sql apples", Rules: map[string]interface{}{"one": "%d яблоко", "other": "%d яблок"}},
+			{ID: "sql hello", Message: "Привет"},
+		},
+	}, messages)
+}
+
+func Test_SourceLoadErrors(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name   string
+		insert string
+	}{
+		{
+			name:   "wrong language tag",
+			insert: `INSERT INTO messages (tag, id, message) VALUES ('-', 'id', 'message')`,
+		},
+		{
+			name:   "wrong rules",
+			insert: `INSERT INTO messages (tag, id, rules) VALUES ('en', 'id', '{')`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name,
+			func(t *testing.T) {
+				db := open(t)
+
+				_, err := db.Exec(tc.insert)
+				assert.NoError(t, err)
+
+				_, err = New(db, "messages").Load(context.Background())
+				assert.Error(t, err)
+			},
+		)
+	}
+
+	_, err := New(open(t), "unknown").Load(context.Background())
+	assert.Error(t, err)
+}
+
+func Test_I18nOverrides(t *testing.T) {
+	t.Parallel()
+
+	db := open(t)
+
+	dir := t.TempDir()
+	data := `[{"id": "sql title", "message": "Title"}, {"id": "sql button", "message": "Send"}]`
+
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "en"), []byte(data), 0o600))
+
+	_, err := db.Exec(`INSERT INTO messages (tag, id, message) VALUES
+		('en', 'sql title', 'New title'),
+		('de', 'sql title', 'Titel')`)
+	assert.NoError(t, err)
+
+	tr := i18n.New(&i18n.Config{
+		Path:     dir,
+		Fallback: language.English,
+		Sources:  []i18n.Source{New(db, "messages")},
+	})
+	assert.NoError(t, tr.Load())
+
+	en := tr.Printer(language.English)
+
+	assert.Equal(t, "New title", en.Sprintf("sql title"))
+	assert.Equal(t, "Send", en.Sprintf("sql button"))
+	assert.Equal(t, "Titel", tr.Printer(language.German).Sprintf("sql title"))
+
+	// Remove the override at runtime.
+	_, err = db.Exec(`DELETE FROM messages WHERE tag = 'en'`)
+	assert.NoError(t, err)
+
+	assert.NoError(t, tr.LoadSources(context.Background()))
+	assert.Equal(t, "Title", en.Sprintf("sql title"))
+
+	m, ok := tr.Message(language.English, "sql title")
+	assert.True(t, ok)
+	assert.Equal(t, "Title", m.Message)
+}
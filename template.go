@@ -0,0 +1,160 @@
+package i18n
+
+import (
+	"context"
+	"fmt"
+	htmltemplate "html/template"
+	"reflect"
+	texttemplate "text/template"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+// FuncMap returns html/template functions:
+//
+//	{{ t . "id" args... }}       translated message
+//	{{ tn . "id" count args... }} translated plural message
+//	{{ lang . }}                  language tag
+//	{{ dir . }}                   text direction, `ltr` or `rtl`
+//
+// The first argument is a language source: context.Context, a value with
+// the Context method (e.g. *http.Request), language.Tag, a language tag
+// string or *message.Printer. A printer has no language, so `lang` and
+// `dir` return the fallback language for it. Translation markup is trusted,
+// arguments except numbers and template.HTML values are escaped after
+// formatting.
+func (i *I18n) FuncMap() htmltemplate.FuncMap {
+	return htmltemplate.FuncMap{
+		"t": func(src interface{}, id string, args ...interface{}) htmltemplate.HTML {
+			return i.translateHTML(src, id, args)
+		},
+		"tn": func(src interface{}, id string, count interface{}, args ...interface{}) htmltemplate.HTML {
+			return i.translateHTML(src, id, append([]interface{}{count}, args...))
+		},
+		"lang": i.templateLanguage,
+		"dir":  i.templateDirection,
+	}
+}
+
+// TextFuncMap returns text/template functions, the same as FuncMap, but
+// arguments are not escaped.
+func (i *I18n) TextFuncMap() texttemplate.FuncMap {
+	return texttemplate.FuncMap{
+		"t": func(src interface{}, id string, args ...interface{}) string {
+			p := i.templatePrinter(src)
+
+			return p.Sprintf(id, localizeArgs(p, args)...)
+		},
+		"tn": func(src interface{}, id string, count interface{}, args ...interface{}) string {
+			p := i.templatePrinter(src)
+
+			return p.Sprintf(id, localizeArgs(p, append([]interface{}{count}, args...))...)
+		},
+		"lang": i.templateLanguage,
+		"dir":  i.templateDirection,
+	}
+}
+
+// Translate a message with escaped arguments.
+func (i *I18n) translateHTML(src interface{}, id string, args []interface{}) htmltemplate.HTML {
+	p := i.templatePrinter(src)
+
+	escaped := make([]interface{}, len(args))
+
+	for n, arg := range localizeArgs(p, args) {
+		escaped[n] = escapeArg(p, arg)
+	}
+
+	return htmltemplate.HTML(p.Sprintf(id, escaped...))
+}
+
+// Escape a message argument. Trusted HTML is kept as is, numbers are kept to
+// select plural forms, other values are escaped after formatting.
+func escapeArg(p *message.Printer, arg interface{}) interface{} {
+	if html, ok := arg.(htmltemplate.HTML); ok {
+		return string(html)
+	}
+
+	switch reflect.ValueOf(arg).Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return arg
+	}
+
+	return escapedArg{arg: arg, printer: p}
+}
+
+// Message argument escaped after formatting.
+type escapedArg struct {
+	arg     interface{}
+	printer *message.Printer
+}
+
+// Format the argument with the verb and flags of the placeholder and escape
+// the result.
+func (e escapedArg) Format(s fmt.State, verb rune) {
+	fmt.Fprint(s, htmltemplate.HTMLEscapeString(e.printer.Sprintf(itemFormat(s, verb), e.arg)))
+}
+
+// Get the language of the template language source.
+func (i *I18n) templateLanguage(src interface{}) string {
+	tag, _ := i.templateLocale(src)
+
+	return tag.String()
+}
+
+// Get the text direction of the template language source.
+func (i *I18n) templateDirection(src interface{}) string {
+	tag, _ := i.templateLocale(src)
+
+	return direction(tag).String()
+}
+
+// Get a printer of the template language source.
+func (i *I18n) templatePrinter(src interface{}) *message.Printer {
+	if p, ok := src.(*message.Printer); ok {
+		return p
+	}
+
+	_, p := i.templateLocale(src)
+
+	return p
+}
+
+// Get the language and the printer of the template language source.
+func (i *I18n) templateLocale(src interface{}) (language.Tag, *message.Printer) {
+	var ctx context.Context
+
+	switch src := src.(type) {
+	case context.Context:
+		ctx = src
+	case interface{ Context() context.Context }:
+		ctx = src.Context()
+	case language.Tag:
+		tag, _ := i.Match(src)
+		return tag, i.Printer(tag)
+	case string:
+		tag, _ := i.Match(language.Make(src))
+		return tag, i.Printer(tag)
+	}
+
+	if ctx == nil {
+		tag, _ := i.Match()
+		return tag, i.Printer(tag)
+	}
+
+	tag, ok := LanguageFromContext(ctx)
+	if ok {
+		tag, _ = i.Match(tag)
+	} else {
+		tag, _ = i.Match()
+	}
+
+	if p, ok := ctx.Value(printerKey{}).(*message.Printer); ok {
+		return tag, p
+	}
+
+	return tag, i.Printer(tag)
+}
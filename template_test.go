@@ -0,0 +1,169 @@
+package i18n
+
+import (
+	"bytes"
+	"context"
+	htmltemplate "html/template"
+	"net/http/httptest"
+	"testing"
+	texttemplate "text/template"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+// Create an i18n with loaded messages for template tests.
+func newTemplateI18n(t *testing.T) *I18n {
+	i18n := New(&Config{Fallback: language.English})
+
+	for _, tag := range []language.Tag{language.English, language.Russian, language.Arabic} {
+		i18n.printer[tag] = message.NewPrinter(tag)
+	}
+
+	assert.NoError(t, message.SetString(language.English, "template hello %v", "Hello, <b>%v</b>!"))
+	assert.NoError(t, message.SetString(language.Russian, "template hello %v", "Привет, <b>%v</b>!"))
+
+	tr := &translation{tag: language.Russian}
+	assert.NoError(t, tr.append([]byte(`[{
+    "id": "template apples %d",
+    "rules": {"one": "%d яблоко", "few": "%d яблока", "many": "%d яблок"}
+  }]`)))
+
+	return i18n
+}
+
+func Test_I18nFuncMap(t *testing.T) {
+	t.Parallel()
+
+	i18n := newTemplateI18n(t)
+	request := httptest.NewRequest("GET", "/", nil)
+	ctx := NewLanguageContext(context.Background(), language.Russian)
+
+	testCases := []struct {
+		name     string
+		template string
+		data     interface{}
+		out      string
+	}{
+		{
+			name:     "escaped argument",
+			template: `{{ t . "template hello %v" "<i>Ivan</i>" }}`,
+			data:     language.Russian,
+			out:      "Привет, <b>&lt;i&gt;Ivan&lt;/i&gt;</b>!",
+		},
+		{
+			name:     "trusted argument",
+			template: `{{ t .Tag "template hello %v" .Name }}`,
+			data: struct {
+				Tag  string
+				Name htmltemplate.HTML
+			}{Tag: "ru", Name: "<i>Ivan</i>"},
+			out: "Привет, <b><i>Ivan</i></b>!",
+		},
+		{
+			name:     "not escaped argument",
+			template: `{{ t .Tag "template flag %t %+v" .Flag .Point }}`,
+			data: struct {
+				Tag   string
+				Flag  bool
+				Point struct{ X, Y int }
+			}{Tag: "en", Flag: true, Point: struct{ X, Y int }{X: 1, Y: 2}},
+			out: "template flag true {X:1 Y:2}",
+		},
+		{
+			name:     "escaped bytes",
+			template: `{{ t .Tag "template value %s" .Value }}`,
+			data: struct {
+				Tag   string
+				Value interface{}
+			}{Tag: "en", Value: []byte("<script>x</script>")},
+			out: "template value &lt;script&gt;x&lt;/script&gt;",
+		},
+		{
+			name:     "escaped slice",
+			template: `{{ t .Tag "template value %v" .Value }}`,
+			data: struct {
+				Tag   string
+				Value interface{}
+			}{Tag: "en", Value: []string{"<img onerror=1>"}},
+			out: "template value [&lt;img onerror=1&gt;]",
+		},
+		{
+			name:     "escaped struct",
+			template: `{{ t .Tag "template value %+v" .Value }}`,
+			data: struct {
+				Tag   string
+				Value interface{}
+			}{Tag: "en", Value: struct{ Name string }{Name: "<b>"}},
+			out: "template value {Name:&lt;b&gt;}",
+		},
+		{
+			name:     "escaped map",
+			template: `{{ t .Tag "template value %v" .Value }}`,
+			data: struct {
+				Tag   string
+				Value interface{}
+			}{Tag: "en", Value: map[string]string{"<a>": "<b>"}},
+			out: "template value map[&lt;a&gt;:&lt;b&gt;]",
+		},
+		{
+			name:     "escaped list",
+			template: `{{ t .Tag "template list %v" .Names }}`,
+			data: struct {
+				Tag   string
+				Names interface{}
+			}{Tag: "en", Names: ListOf([]string{"<i>Ann</i>", "Bob"}, Conjunction)},
+			out: "template list &lt;i&gt;Ann&lt;/i&gt; and Bob",
+		},
+		{
+			name:     "plural",
+			template: `{{ tn . "template apples %d" 5 }}`,
+			data:     ctx,
+			out:      "5 яблок",
+		},
+		{
+			name:     "request",
+			template: `<html lang="{{ lang . }}" dir="{{ dir . }}">{{ t . "template hello %v" "Ivan" }}`,
+			data:     request,
+			out:      `<html lang="en" dir="ltr">Hello, <b>Ivan</b>!`,
+		},
+		{
+			name:     "right-to-left language",
+			template: `<html lang="{{ lang . }}" dir="{{ dir . }}">`,
+			data:     language.Arabic,
+			out:      `<html lang="ar" dir="rtl">`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name,
+			func(t *testing.T) {
+				var out bytes.Buffer
+
+				tmpl, err := htmltemplate.New("").Funcs(i18n.FuncMap()).Parse(tc.template)
+				assert.NoError(t, err)
+
+				assert.NoError(t, tmpl.Execute(&out, tc.data))
+				assert.Equal(t, tc.out, out.String())
+			},
+		)
+	}
+}
+
+func Test_I18nTextFuncMap(t *testing.T) {
+	t.Parallel()
+
+	i18n := newTemplateI18n(t)
+
+	var out bytes.Buffer
+
+	tmpl, err := texttemplate.New("").Funcs(i18n.TextFuncMap()).Parse(
+		`{{ lang . }} {{ dir . }} {{ t . "template hello %v" "<i>Ivan</i>" }} {{ tn . "template apples %d" 2 }}`,
+	)
+	assert.NoError(t, err)
+
+	assert.NoError(t, tmpl.Execute(&out, message.NewPrinter(language.Russian)))
+	assert.Equal(t, "en ltr Привет, <b><i>Ivan</i></b>! 2 яблока", out.String())
+}
@@ -0,0 +1,198 @@
+package i18n
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/number"
+)
+
+// MeasureUnit is a unit of a measure.
+type MeasureUnit string
+
+// Supported units, CLDR unit names.
+const (
+	Byte      MeasureUnit = "byte"
+	Kilobyte  MeasureUnit = "kilobyte"
+	Megabyte  MeasureUnit = "megabyte"
+	Gigabyte  MeasureUnit = "gigabyte"
+	Terabyte  MeasureUnit = "terabyte"
+	Meter     MeasureUnit = "meter"
+	Kilometer MeasureUnit = "kilometer"
+	Mile      MeasureUnit = "mile"
+	Day       MeasureUnit = "day"
+	Hour      MeasureUnit = "hour"
+	Minute    MeasureUnit = "minute"
+	Second    MeasureUnit = "second"
+)
+
+// Measure formats a measure in the printer language with the unit name of
+// the plural category of the value. Short and Medium styles use unit
+// abbreviations, Long and Full styles use unit names, see FormattingOf:
+//
+//	ru.Sprint(i18n.Measure(2, i18n.Kilometer, i18n.Short)) // 2 км
+//	ru.Sprint(i18n.Measure(2, i18n.Kilometer, i18n.Long))  // 2 километра
+func Measure(v interface{}, unit MeasureUnit, style Style) fmt.Formatter {
+	return measure{value: v, unit: unit, style: style}
+}
+
+// ByteSize formats a number of bytes in decimal units from bytes to
+// terabytes, e.g. `1.5 MB` or `250 kB`.
+func ByteSize(n int64, style Style) fmt.Formatter {
+	return byteSize{size: n, style: style}
+}
+
+// Duration formats a duration as a list of days, hours, minutes and seconds,
+// e.g. `2 hr, 5 min`. Zero parts are skipped, fractions of a second are
+// dropped.
+func Duration(d time.Duration, style Style) fmt.Formatter {
+	return duration{duration: d, style: style}
+}
+
+// Measure with a unit.
+type measure struct {
+	value interface{}
+	unit  MeasureUnit
+	style Style
+}
+
+// Format the measure with the printer language.
+func (m measure) Format(s fmt.State, verb rune) {
+	v, ok := toFloat(m.value)
+	if !ok {
+		fmt.Fprintf(s, "%%!%c(%T=%v)", verb, m.value, m.value)
+		return
+	}
+
+	fmt.Fprint(s, formatMeasure(stateLanguage(s), v, 3, m.unit, m.style))
+}
+
+// Number of bytes.
+type byteSize struct {
+	size  int64
+	style Style
+}
+
+// Byte units and their powers of ten.
+var byteUnits = []struct {
+	unit  MeasureUnit
+	power int
+}{
+	{unit: Kilobyte, power: 3},
+	{unit: Megabyte, power: 6},
+	{unit: Gigabyte, power: 9},
+	{unit: Terabyte, power: 12},
+}
+
+// Format the size with the printer language. The number of units keeps one
+// fraction digit below 10 and is rounded to an integer above.
+func (b byteSize) Format(s fmt.State, _ rune) {
+	powers := make([]int, len(byteUnits))
+	for n, unit := range byteUnits {
+		powers[n] = unit.power
+	}
+
+	unit, v := Byte, float64(b.size)
+
+	if n := compactPower(v, powers); n >= 0 {
+		unit, v = byteUnits[n].unit, v/math.Pow10(byteUnits[n].power)
+	}
+
+	digits := 0
+	if unit != Byte && roundCompact(math.Abs(v)) < 10 {
+		digits = 1
+	}
+
+	fmt.Fprint(s, formatMeasure(stateLanguage(s), v, digits, unit, b.style))
+}
+
+// Duration of parts.
+type duration struct {
+	duration time.Duration
+	style    Style
+}
+
+// Duration units from the largest.
+var durationUnits = []struct {
+	unit     MeasureUnit
+	duration time.Duration
+}{
+	{unit: Day, duration: 24 * time.Hour},
+	{unit: Hour, duration: time.Hour},
+	{unit: Minute, duration: time.Minute},
+	{unit: Second, duration: time.Second},
+}
+
+// Format the duration with the printer language, parts are joined with the
+// unit list pattern. A negative duration starts with a minus sign.
+func (d duration) Format(s fmt.State, _ rune) {
+	tag := stateLanguage(s)
+	rest := d.duration
+
+	if rest < 0 {
+		fmt.Fprint(s, "-")
+		rest = -rest
+	}
+
+	var parts []string
+
+	for _, unit := range durationUnits {
+		count := rest / unit.duration
+		rest -= count * unit.duration
+
+		if count != 0 {
+			parts = append(parts, formatMeasure(tag, float64(count), 0, unit.unit, d.style))
+		}
+	}
+
+	if len(parts) == 0 {
+		parts = append(parts, formatMeasure(tag, 0, 0, Second, d.style))
+	}
+
+	fmt.Fprint(s, List(tag, parts, Unit))
+}
+
+// Format a value with at most digits fraction digits and the unit name of
+// its plural category.
+func formatMeasure(tag language.Tag, v float64, digits int, unit MeasureUnit, style Style) string {
+	text := numberPrinter(tag).Sprint(number.Decimal(v, number.MaxFractionDigits(digits)))
+
+	// Visible digits of the value select the plural category, trailing zeros
+	// are not written, e.g. `1 kilometer` and `1.5 kilometers`.
+	visible := strconv.FormatFloat(math.Abs(v), 'f', digits, 64)
+	if strings.Contains(visible, ".") {
+		visible = strings.TrimRight(strings.TrimRight(visible, "0"), ".")
+	}
+
+	integer, fraction, _ := strings.Cut(visible, ".")
+	i, _ := strconv.Atoi(integer)
+	f, _ := strconv.Atoi(fraction)
+
+	forms := unitData(tag)[unit][0]
+	if style >= Long {
+		forms = unitData(tag)[unit][1]
+	}
+
+	if forms == nil {
+		return text + " " + string(unit)
+	}
+
+	return strings.Replace(pluralForm(forms, pluralCategory(tag, i, len(fraction), f)), "{0}", text, 1)
+}
+
+// Get unit names of the language, see FormattingOf.
+func unitData(tag language.Tag) map[MeasureUnit][2]map[string]string {
+	key, ok := localeKey(tag, func(key string) bool {
+		_, ok := units[key]
+		return ok
+	})
+	if !ok {
+		key = "en"
+	}
+
+	return units[key]
+}
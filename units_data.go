@@ -0,0 +1,237 @@
+package i18n
+
+// Unit names of the same text in all plural categories.
+func invariant(text string) map[string]string {
+	return map[string]string{"other": text}
+}
+
+// Unit names of `one` and `other` plural categories.
+func oneOther(one, other string) map[string]string {
+	return map[string]string{"one": one, "other": other}
+}
+
+// Unit names of `one`, `few`, `many` and `other` plural categories.
+func oneFewMany(one, few, many, other string) map[string]string {
+	return map[string]string{"one": one, "few": few, "many": many, "other": other}
+}
+
+// Unit names of `one`, `two`, `few`, `many` and `other` plural categories,
+// `zero` uses the `other` name.
+func oneTwoFewMany(one, two, few, many, other string) map[string]string {
+	return map[string]string{"zero": other, "one": one, "two": two, "few": few, "many": many, "other": other}
+}
+
+// Unit names of CLDR by language: abbreviations and full names by plural
+// category.
+var units = map[string]map[MeasureUnit][2]map[string]string{
+	"en": {
+		Byte:      {invariant("{0} byte"), oneOther("{0} byte", "{0} bytes")},
+		Kilobyte:  {invariant("{0} kB"), oneOther("{0} kilobyte", "{0} kilobytes")},
+		Megabyte:  {invariant("{0} MB"), oneOther("{0} megabyte", "{0} megabytes")},
+		Gigabyte:  {invariant("{0} GB"), oneOther("{0} gigabyte", "{0} gigabytes")},
+		Terabyte:  {invariant("{0} TB"), oneOther("{0} terabyte", "{0} terabytes")},
+		Meter:     {invariant("{0} m"), oneOther("{0} meter", "{0} meters")},
+		Kilometer: {invariant("{0} km"), oneOther("{0} kilometer", "{0} kilometers")},
+		Mile:      {invariant("{0} mi"), oneOther("{0} mile", "{0} miles")},
+		Day:       {oneOther("{0} day", "{0} days"), oneOther("{0} day", "{0} days")},
+		Hour:      {invariant("{0} hr"), oneOther("{0} hour", "{0} hours")},
+		Minute:    {invariant("{0} min"), oneOther("{0} minute", "{0} minutes")},
+		Second:    {invariant("{0} sec"), oneOther("{0} second", "{0} seconds")},
+	},
+	"de": {
+		Byte:      {invariant("{0} Byte"), invariant("{0} Byte")},
+		Kilobyte:  {invariant("{0} kB"), invariant("{0} Kilobyte")},
+		Megabyte:  {invariant("{0} MB"), invariant("{0} Megabyte")},
+		Gigabyte:  {invariant("{0} GB"), invariant("{0} Gigabyte")},
+		Terabyte:  {invariant("{0} TB"), invariant("{0} Terabyte")},
+		Meter:     {invariant("{0} m"), invariant("{0} Meter")},
+		Kilometer: {invariant("{0} km"), invariant("{0} Kilometer")},
+		Mile:      {invariant("{0} mi"), oneOther("{0} Meile", "{0} Meilen")},
+		Day:       {invariant("{0} Tg."), oneOther("{0} Tag", "{0} Tage")},
+		Hour:      {invariant("{0} Std."), oneOther("{0} Stunde", "{0} Stunden")},
+		Minute:    {invariant("{0} Min."), oneOther("{0} Minute", "{0} Minuten")},
+		Second:    {invariant("{0} Sek."), oneOther("{0} Sekunde", "{0} Sekunden")},
+	},
+	"es": {
+		Byte:      {invariant("{0} B"), oneOther("{0} byte", "{0} bytes")},
+		Kilobyte:  {invariant("{0} kB"), oneOther("{0} kilobyte", "{0} kilobytes")},
+		Megabyte:  {invariant("{0} MB"), oneOther("{0} megabyte", "{0} megabytes")},
+		Gigabyte:  {invariant("{0} GB"), oneOther("{0} gigabyte", "{0} gigabytes")},
+		Terabyte:  {invariant("{0} TB"), oneOther("{0} terabyte", "{0} terabytes")},
+		Meter:     {invariant("{0} m"), oneOther("{0} metro", "{0} metros")},
+		Kilometer: {invariant("{0} km"), oneOther("{0} kilómetro", "{0} kilómetros")},
+		Mile:      {invariant("{0} mi"), oneOther("{0} milla", "{0} millas")},
+		Day:       {invariant("{0} d"), oneOther("{0} día", "{0} días")},
+		Hour:      {invariant("{0} h"), oneOther("{0} hora", "{0} horas")},
+		Minute:    {invariant("{0} min"), oneOther("{0} minuto", "{0} minutos")},
+		Second:    {invariant("{0} s"), oneOther("{0} segundo", "{0} segundos")},
+	},
+	"fr": {
+		Byte:      {invariant("{0} o"), oneOther("{0} octet", "{0} octets")},
+		Kilobyte:  {invariant("{0} ko"), oneOther("{0} kilooctet", "{0} kilooctets")},
+		Megabyte:  {invariant("{0} Mo"), oneOther("{0} mégaoctet", "{0} mégaoctets")},
+		Gigabyte:  {invariant("{0} Go"), oneOther("{0} gigaoctet", "{0} gigaoctets")},
+		Terabyte:  {invariant("{0} To"), oneOther("{0} téraoctet", "{0} téraoctets")},
+		Meter:     {invariant("{0} m"), oneOther("{0} mètre", "{0} mètres")},
+		Kilometer: {invariant("{0} km"), oneOther("{0} kilomètre", "{0} kilomètres")},
+		Mile:      {invariant("{0} mi"), oneOther("{0} mile", "{0} miles")},
+		Day:       {invariant("{0} j"), oneOther("{0} jour", "{0} jours")},
+		Hour:      {invariant("{0} h"), oneOther("{0} heure", "{0} heures")},
+		Minute:    {invariant("{0} min"), oneOther("{0} minute", "{0} minutes")},
+		Second:    {invariant("{0} s"), oneOther("{0} seconde", "{0} secondes")},
+	},
+	"it": {
+		Byte:      {invariant("{0} byte"), invariant("{0} byte")},
+		Kilobyte:  {invariant("{0} kB"), invariant("{0} kilobyte")},
+		Megabyte:  {invariant("{0} MB"), invariant("{0} megabyte")},
+		Gigabyte:  {invariant("{0} GB"), invariant("{0} gigabyte")},
+		Terabyte:  {invariant("{0} TB"), invariant("{0} terabyte")},
+		Meter:     {invariant("{0} m"), oneOther("{0} metro", "{0} metri")},
+		Kilometer: {invariant("{0} km"), oneOther("{0} chilometro", "{0} chilometri")},
+		Mile:      {invariant("{0} mi"), oneOther("{0} miglio", "{0} miglia")},
+		Day:       {invariant("{0} g"), oneOther("{0} giorno", "{0} giorni")},
+		Hour:      {invariant("{0} h"), oneOther("{0} ora", "{0} ore")},
+		Minute:    {invariant("{0} min"), oneOther("{0} minuto", "{0} minuti")},
+		Second:    {invariant("{0} s"), oneOther("{0} secondo", "{0} secondi")},
+	},
+	"ja": {
+		Byte:      {invariant("{0} byte"), invariant("{0} バイト")},
+		Kilobyte:  {invariant("{0} kB"), invariant("{0} キロバイト")},
+		Megabyte:  {invariant("{0} MB"), invariant("{0} メガバイト")},
+		Gigabyte:  {invariant("{0} GB"), invariant("{0} ギガバイト")},
+		Terabyte:  {invariant("{0} TB"), invariant("{0} テラバイト")},
+		Meter:     {invariant("{0} m"), invariant("{0} メートル")},
+		Kilometer: {invariant("{0} km"), invariant("{0} キロメートル")},
+		Mile:      {invariant("{0} マイル"), invariant("{0} マイル")},
+		Day:       {invariant("{0} 日"), invariant("{0} 日")},
+		Hour:      {invariant("{0} 時間"), invariant("{0} 時間")},
+		Minute:    {invariant("{0} 分"), invariant("{0} 分")},
+		Second:    {invariant("{0} 秒"), invariant("{0} 秒")},
+	},
+	"ko": {
+		Byte:      {invariant("{0}byte"), invariant("{0}바이트")},
+		Kilobyte:  {invariant("{0}kB"), invariant("{0}킬로바이트")},
+		Megabyte:  {invariant("{0}MB"), invariant("{0}메가바이트")},
+		Gigabyte:  {invariant("{0}GB"), invariant("{0}기가바이트")},
+		Terabyte:  {invariant("{0}TB"), invariant("{0}테라바이트")},
+		Meter:     {invariant("{0}m"), invariant("{0}미터")},
+		Kilometer: {invariant("{0}km"), invariant("{0}킬로미터")},
+		Mile:      {invariant("{0}mi"), invariant("{0}마일")},
+		Day:       {invariant("{0}일"), invariant("{0}일")},
+		Hour:      {invariant("{0}시간"), invariant("{0}시간")},
+		Minute:    {invariant("{0}분"), invariant("{0}분")},
+		Second:    {invariant("{0}초"), invariant("{0}초")},
+	},
+	"nl": {
+		Byte:      {invariant("{0} byte"), oneOther("{0} byte", "{0} bytes")},
+		Kilobyte:  {invariant("{0} kB"), oneOther("{0} kilobyte", "{0} kilobytes")},
+		Megabyte:  {invariant("{0} MB"), oneOther("{0} megabyte", "{0} megabytes")},
+		Gigabyte:  {invariant("{0} GB"), oneOther("{0} gigabyte", "{0} gigabytes")},
+		Terabyte:  {invariant("{0} TB"), oneOther("{0} terabyte", "{0} terabytes")},
+		Meter:     {invariant("{0} m"), invariant("{0} meter")},
+		Kilometer: {invariant("{0} km"), invariant("{0} kilometer")},
+		Mile:      {invariant("{0} mi"), invariant("{0} mijl")},
+		Day:       {oneOther("{0} dag", "{0} dagen"), oneOther("{0} dag", "{0} dagen")},
+		Hour:      {invariant("{0} uur"), invariant("{0} uur")},
+		Minute:    {invariant("{0} min"), oneOther("{0} minuut", "{0} minuten")},
+		Second:    {invariant("{0} sec"), oneOther("{0} seconde", "{0} seconden")},
+	},
+	"pl": {
+		Byte:      {invariant("{0} B"), oneFewMany("{0} bajt", "{0} bajty", "{0} bajtów", "{0} bajta")},
+		Kilobyte:  {invariant("{0} kB"), oneFewMany("{0} kilobajt", "{0} kilobajty", "{0} kilobajtów", "{0} kilobajta")},
+		Megabyte:  {invariant("{0} MB"), oneFewMany("{0} megabajt", "{0} megabajty", "{0} megabajtów", "{0} megabajta")},
+		Gigabyte:  {invariant("{0} GB"), oneFewMany("{0} gigabajt", "{0} gigabajty", "{0} gigabajtów", "{0} gigabajta")},
+		Terabyte:  {invariant("{0} TB"), oneFewMany("{0} terabajt", "{0} terabajty", "{0} terabajtów", "{0} terabajta")},
+		Meter:     {invariant("{0} m"), oneFewMany("{0} metr", "{0} metry", "{0} metrów", "{0} metra")},
+		Kilometer: {invariant("{0} km"), oneFewMany("{0} kilometr", "{0} kilometry", "{0} kilometrów", "{0} kilometra")},
+		Mile:      {invariant("{0} mi"), oneFewMany("{0} mila", "{0} mile", "{0} mil", "{0} mili")},
+		Day:       {oneFewMany("{0} dzień", "{0} dni", "{0} dni", "{0} dnia"), oneFewMany("{0} dzień", "{0} dni", "{0} dni", "{0} dnia")},
+		Hour:      {invariant("{0} godz."), oneFewMany("{0} godzina", "{0} godziny", "{0} godzin", "{0} godziny")},
+		Minute:    {invariant("{0} min"), oneFewMany("{0} minuta", "{0} minuty", "{0} minut", "{0} minuty")},
+		Second:    {invariant("{0} sek."), oneFewMany("{0} sekunda", "{0} sekundy", "{0} sekund", "{0} sekundy")},
+	},
+	"pt": {
+		Byte:      {invariant("{0} byte"), oneOther("{0} byte", "{0} bytes")},
+		Kilobyte:  {invariant("{0} kB"), oneOther("{0} kilobyte", "{0} kilobytes")},
+		Megabyte:  {invariant("{0} MB"), oneOther("{0} megabyte", "{0} megabytes")},
+		Gigabyte:  {invariant("{0} GB"), oneOther("{0} gigabyte", "{0} gigabytes")},
+		Terabyte:  {invariant("{0} TB"), oneOther("{0} terabyte", "{0} terabytes")},
+		Meter:     {invariant("{0} m"), oneOther("{0} metro", "{0} metros")},
+		Kilometer: {invariant("{0} km"), oneOther("{0} quilômetro", "{0} quilômetros")},
+		Mile:      {invariant("{0} mi"), oneOther("{0} milha", "{0} milhas")},
+		Day:       {oneOther("{0} dia", "{0} dias"), oneOther("{0} dia", "{0} dias")},
+		Hour:      {invariant("{0} h"), oneOther("{0} hora", "{0} horas")},
+		Minute:    {invariant("{0} min"), oneOther("{0} minuto", "{0} minutos")},
+		Second:    {invariant("{0} s"), oneOther("{0} segundo", "{0} segundos")},
+	},
+	"ru": {
+		Byte:      {invariant("{0} Б"), oneFewMany("{0} байт", "{0} байта", "{0} байт", "{0} байта")},
+		Kilobyte:  {invariant("{0} кБ"), oneFewMany("{0} килобайт", "{0} килобайта", "{0} килобайт", "{0} килобайта")},
+		Megabyte:  {invariant("{0} МБ"), oneFewMany("{0} мегабайт", "{0} мегабайта", "{0} мегабайт", "{0} мегабайта")},
+		Gigabyte:  {invariant("{0} ГБ"), oneFewMany("{0} гигабайт", "{0} гигабайта", "{0} гигабайт", "{0} гигабайта")},
+		Terabyte:  {invariant("{0} ТБ"), oneFewMany("{0} терабайт", "{0} терабайта", "{0} терабайт", "{0} терабайта")},
+		Meter:     {invariant("{0} м"), oneFewMany("{0} метр", "{0} метра", "{0} метров", "{0} метра")},
+		Kilometer: {invariant("{0} км"), oneFewMany("{0} километр", "{0} километра", "{0} километров", "{0} километра")},
+		Mile:      {invariant("{0} ми"), oneFewMany("{0} миля", "{0} мили", "{0} миль", "{0} мили")},
+		Day:       {invariant("{0} дн."), oneFewMany("{0} день", "{0} дня", "{0} дней", "{0} дня")},
+		Hour:      {invariant("{0} ч"), oneFewMany("{0} час", "{0} часа", "{0} часов", "{0} часа")},
+		Minute:    {invariant("{0} мин"), oneFewMany("{0} минута", "{0} минуты", "{0} минут", "{0} минуты")},
+		Second:    {invariant("{0} с"), oneFewMany("{0} секунда", "{0} секунды", "{0} секунд", "{0} секунды")},
+	},
+	"uk": {
+		Byte:      {invariant("{0} Б"), oneFewMany("{0} байт", "{0} байти", "{0} байтів", "{0} байта")},
+		Kilobyte:  {invariant("{0} КБ"), oneFewMany("{0} кілобайт", "{0} кілобайти", "{0} кілобайтів", "{0} кілобайта")},
+		Megabyte:  {invariant("{0} МБ"), oneFewMany("{0} мегабайт", "{0} мегабайти", "{0} мегабайтів", "{0} мегабайта")},
+		Gigabyte:  {invariant("{0} ГБ"), oneFewMany("{0} гігабайт", "{0} гігабайти", "{0} гігабайтів", "{0} гігабайта")},
+		Terabyte:  {invariant("{0} ТБ"), oneFewMany("{0} терабайт", "{0} терабайти", "{0} терабайтів", "{0} терабайта")},
+		Meter:     {invariant("{0} м"), oneFewMany("{0} метр", "{0} метри", "{0} метрів", "{0} метра")},
+		Kilometer: {invariant("{0} км"), oneFewMany("{0} кілометр", "{0} кілометри", "{0} кілометрів", "{0} кілометра")},
+		Mile:      {invariant("{0} милі"), oneFewMany("{0} миля", "{0} милі", "{0} миль", "{0} милі")},
+		Day:       {invariant("{0} дн."), oneFewMany("{0} день", "{0} дні", "{0} днів", "{0} дня")},
+		Hour:      {invariant("{0} год"), oneFewMany("{0} година", "{0} години", "{0} годин", "{0} години")},
+		Minute:    {invariant("{0} хв"), oneFewMany("{0} хвилина", "{0} хвилини", "{0} хвилин", "{0} хвилини")},
+		Second:    {invariant("{0} с"), oneFewMany("{0} секунда", "{0} секунди", "{0} секунд", "{0} секунди")},
+	},
+	"zh": {
+		Byte:      {invariant("{0} byte"), invariant("{0}字节")},
+		Kilobyte:  {invariant("{0} kB"), invariant("{0}千字节")},
+		Megabyte:  {invariant("{0} MB"), invariant("{0}兆字节")},
+		Gigabyte:  {invariant("{0} GB"), invariant("{0}吉字节")},
+		Terabyte:  {invariant("{0} TB"), invariant("{0}太字节")},
+		Meter:     {invariant("{0} 米"), invariant("{0}米")},
+		Kilometer: {invariant("{0} 公里"), invariant("{0}公里")},
+		Mile:      {invariant("{0} 英里"), invariant("{0}英里")},
+		Day:       {invariant("{0}天"), invariant("{0}天")},
+		Hour:      {invariant("{0}小时"), invariant("{0}小时")},
+		Minute:    {invariant("{0}分钟"), invariant("{0}分钟")},
+		Second:    {invariant("{0}秒"), invariant("{0}秒钟")},
+	},
+	"zh-Hant": {
+		Byte:      {invariant("{0} byte"), invariant("{0} 位元組")},
+		Kilobyte:  {invariant("{0} kB"), invariant("{0} 千位元組")},
+		Megabyte:  {invariant("{0} MB"), invariant("{0} 百萬位元組")},
+		Gigabyte:  {invariant("{0} GB"), invariant("{0} 十億位元組")},
+		Terabyte:  {invariant("{0} TB"), invariant("{0} 兆位元組")},
+		Meter:     {invariant("{0} 公尺"), invariant("{0} 公尺")},
+		Kilometer: {invariant("{0} 公里"), invariant("{0} 公里")},
+		Mile:      {invariant("{0} 英里"), invariant("{0} 英里")},
+		Day:       {invariant("{0} 天"), invariant("{0} 天")},
+		Hour:      {invariant("{0} 小時"), invariant("{0} 小時")},
+		Minute:    {invariant("{0} 分鐘"), invariant("{0} 分鐘")},
+		Second:    {invariant("{0} 秒"), invariant("{0} 秒")},
+	},
+	"ar": {
+		Byte:      {invariant("{0} بايت"), invariant("{0} بايت")},
+		Kilobyte:  {invariant("{0} ك.بايت"), invariant("{0} كيلوبايت")},
+		Megabyte:  {invariant("{0} م.بايت"), invariant("{0} ميغابايت")},
+		Gigabyte:  {invariant("{0} ج.بايت"), invariant("{0} غيغابايت")},
+		Terabyte:  {invariant("{0} ت.بايت"), invariant("{0} تيرابايت")},
+		Meter:     {invariant("{0} م"), oneTwoFewMany("متر", "متران", "{0} أمتار", "{0} مترًا", "{0} متر")},
+		Kilometer: {invariant("{0} كم"), oneTwoFewMany("كيلومتر", "كيلومتران", "{0} كيلومترات", "{0} كيلومترًا", "{0} كيلومتر")},
+		Mile:      {invariant("{0} ميل"), oneTwoFewMany("ميل", "ميلان", "{0} أميال", "{0} ميلًا", "{0} ميل")},
+		Day:       {oneTwoFewMany("يوم", "يومان", "{0} أيام", "{0} يومًا", "{0} يوم"), oneTwoFewMany("يوم", "يومان", "{0} أيام", "{0} يومًا", "{0} يوم")},
+		Hour:      {invariant("{0} س"), oneTwoFewMany("ساعة", "ساعتان", "{0} ساعات", "{0} ساعة", "{0} ساعة")},
+		Minute:    {invariant("{0} د"), oneTwoFewMany("دقيقة", "دقيقتان", "{0} دقائق", "{0} دقيقة", "{0} دقيقة")},
+		Second:    {invariant("{0} ث"), oneTwoFewMany("ثانية", "ثانيتان", "{0} ثوانٍ", "{0} ثانية", "{0} ثانية")},
+	},
+}
@@ -0,0 +1,56 @@
+package i18n
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+func Test_Units(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+		tag  language.Tag
+		arg  interface{}
+		out  string
+	}{
+		{name: "short", tag: language.English, arg: Measure(5, Kilometer, Short), out: "5 km"},
+		{name: "long one", tag: language.English, arg: Measure(1, Kilometer, Long), out: "1 kilometer"},
+		{name: "long other", tag: language.English, arg: Measure(1.5, Mile, Long), out: "1.5 miles"},
+		{name: "long grouping", tag: language.English, arg: Measure(1200, Meter, Long), out: "1,200 meters"},
+		{name: "ru one", tag: language.Russian, arg: Measure(21, Kilometer, Long), out: "21 километр"},
+		{name: "ru few", tag: language.Russian, arg: Measure(2, Kilometer, Long), out: "2 километра"},
+		{name: "ru many", tag: language.Russian, arg: Measure(5, Kilometer, Long), out: "5 километров"},
+		{name: "ru fraction", tag: language.Russian, arg: Measure(2.5, Kilometer, Long), out: "2,5 километра"},
+		{name: "ru short", tag: language.Russian, arg: Measure(5, Kilometer, Short), out: "5 км"},
+		{name: "ar two", tag: language.Arabic, arg: Measure(2, Kilometer, Long), out: "كيلومتران"},
+		{name: "zh-TW", tag: language.MustParse("zh-TW"), arg: Measure(2, Hour, Long), out: "2 小時"},
+		{name: "language without names", tag: language.Swahili, arg: Measure(2, Hour, Long), out: "2 hours"},
+		{name: "bytes", tag: language.English, arg: ByteSize(999, Short), out: "999 byte"},
+		{name: "kilobytes", tag: language.English, arg: ByteSize(250_000, Short), out: "250 kB"},
+		{name: "megabytes", tag: language.English, arg: ByteSize(1_536_000, Short), out: "1.5 MB"},
+		{name: "carry", tag: language.English, arg: ByteSize(999_999, Short), out: "1 MB"},
+		{name: "without carry", tag: language.English, arg: ByteSize(950_000, Short), out: "950 kB"},
+		{name: "bytes long", tag: language.German, arg: ByteSize(1_500_000_000, Long), out: "1,5 Gigabyte"},
+		{name: "duration", tag: language.English, arg: Duration(2*time.Hour+5*time.Minute, Short), out: "2 hr, 5 min"},
+		{name: "duration long", tag: language.English, arg: Duration(26*time.Hour+time.Second, Long), out: "1 day, 2 hours, 1 second"},
+		{name: "duration ru", tag: language.Russian, arg: Duration(2*time.Hour+5*time.Minute, Long), out: "2 часа 5 минут"},
+		{name: "duration negative", tag: language.English, arg: Duration(-90*time.Second, Short), out: "-1 min, 30 sec"},
+		{name: "duration zero", tag: language.English, arg: Duration(0, Short), out: "0 sec"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name,
+			func(t *testing.T) {
+				p := message.NewPrinter(tc.tag)
+
+				assert.Equal(t, tc.out, p.Sprint(tc.arg))
+			},
+		)
+	}
+}
@@ -0,0 +1,316 @@
+package i18n
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// FormatXCStrings is an Xcode String Catalog.
+const FormatXCStrings Format = "xcstrings"
+
+// Decoder of Xcode String Catalogs. A catalog contains translations of all
+// languages: loaded as a bundle it provides every language, in a language
+// folder it provides the language of the folder. Plural variations and one
+// plural substitution per string are converted to plural rules.
+type xcstringsDecoder struct{}
+
+// Xcode String Catalog.
+type xcCatalog struct {
+	SourceLanguage string              `json:"sourceLanguage"`
+	Strings        map[string]xcString `json:"strings"`
+	Version        string              `json:"version"`
+}
+
+// String of the catalog.
+type xcString struct {
+	Comment         string                    `json:"comment,omitempty"`
+	ExtractionState string                    `json:"extractionState,omitempty"`
+	Localizations   map[string]xcLocalization `json:"localizations,omitempty"`
+}
+
+// Localization of a string.
+type xcLocalization struct {
+	StringUnit    *xcStringUnit             `json:"stringUnit,omitempty"`
+	Substitutions map[string]xcSubstitution `json:"substitutions,omitempty"`
+	Variations    *xcVariations             `json:"variations,omitempty"`
+}
+
+// Translated text.
+type xcStringUnit struct {
+	State string `json:"state"`
+	Value string `json:"value"`
+}
+
+// Substitution of a plural variable `%#@name@`.
+type xcSubstitution struct {
+	ArgNum          int          `json:"argNum,omitempty"`
+	FormatSpecifier string       `json:"formatSpecifier,omitempty"`
+	Variations      xcVariations `json:"variations"`
+}
+
+// Variations of a string.
+type xcVariations struct {
+	Plural map[string]xcLocalization `json:"plural,omitempty"`
+	Device map[string]xcLocalization `json:"device,omitempty"`
+}
+
+// Decode translation messages of the source language.
+func (d xcstringsDecoder) Decode(data []byte) ([]Message, error) {
+	return publicMessages(d.decode(data))
+}
+
+// Decode translation messages of the source language.
+func (d xcstringsDecoder) decode(data []byte) ([]translationMessage, error) {
+	var catalog xcCatalog
+	if err := json.Unmarshal(data, &catalog); err != nil {
+		return nil, err
+	}
+
+	tag, err := language.Parse(catalog.SourceLanguage)
+	if err != nil {
+		return nil, err
+	}
+
+	messages, err := catalog.messages()
+	if err != nil {
+		return nil, err
+	}
+
+	return messages[tag], nil
+}
+
+// Decode translation messages of all languages.
+func (xcstringsDecoder) decodeBundle(data []byte) (map[language.Tag][]translationMessage, error) {
+	var catalog xcCatalog
+	if err := json.Unmarshal(data, &catalog); err != nil {
+		return nil, err
+	}
+
+	return catalog.messages()
+}
+
+// Get messages grouped by language. A string without a localization of the
+// source language uses the key as the source text.
+func (c xcCatalog) messages() (map[language.Tag][]translationMessage, error) {
+	source, err := language.Parse(c.SourceLanguage)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(c.Strings))
+	for id := range c.Strings {
+		ids = append(ids, id)
+	}
+
+	sort.Strings(ids)
+
+	result := make(map[language.Tag][]translationMessage)
+
+	for _, id := range ids {
+		entry := c.Strings[id]
+
+		if _, ok := entry.Localizations[c.SourceLanguage]; !ok {
+			text := appleToPrintf(id)
+			result[source] = append(result[source], translationMessage{
+				ID:          id,
+				Message:     &text,
+				Description: entry.Comment,
+			})
+		}
+
+		for key, localization := range entry.Localizations {
+			tag, err := language.Parse(key)
+			if err != nil {
+				return nil, err
+			}
+
+			m, ok, err := localization.message()
+			if err != nil {
+				return nil, &ErrorDecode{
+					Format:  "xcstrings",
+					Message: fmt.Sprintf("message `%v` of `%v`: %v", id, key, err),
+				}
+			}
+
+			if !ok {
+				continue
+			}
+
+			m.ID = id
+			m.Description = entry.Comment
+
+			result[tag] = append(result[tag], m)
+		}
+	}
+
+	return result, nil
+}
+
+// Convert the localization to a message. It isn't ok if the localization
+// has no text.
+func (l xcLocalization) message() (translationMessage, bool, error) {
+	var m translationMessage
+
+	switch {
+	case l.Variations != nil:
+		if len(l.Variations.Device) > 0 {
+			return m, false, fmt.Errorf("device variations are not supported")
+		}
+
+		forms, err := xcForms(l.Variations.Plural, "")
+		if err != nil {
+			return m, false, err
+		}
+
+		m.Rules, err = appleVariable{name: "plural", arg: 1}.rules(forms)
+
+		return m, true, err
+	case l.StringUnit == nil:
+		return m, false, nil
+	case len(l.Substitutions) == 0:
+		text := appleToPrintf(l.StringUnit.Value)
+		m.Message = &text
+
+		return m, true, nil
+	}
+
+	variable, ok, err := splitAppleVariable(l.StringUnit.Value)
+	if err != nil {
+		return m, false, err
+	}
+
+	substitution, found := l.Substitutions[variable.name]
+	if !ok || !found {
+		return m, false, fmt.Errorf("substitution of `%v` is not found", l.StringUnit.Value)
+	}
+
+	if substitution.ArgNum > 0 {
+		variable.arg = substitution.ArgNum
+	}
+
+	specifier := substitution.FormatSpecifier
+	if specifier == "" {
+		specifier = "lld"
+	}
+
+	forms, err := xcForms(
+		substitution.Variations.Plural,
+		"%"+strconv.Itoa(variable.arg)+"$"+specifier,
+	)
+	if err != nil {
+		return m, false, err
+	}
+
+	m.Rules, err = variable.rules(forms)
+
+	return m, true, err
+}
+
+// Get texts of plural variations by plural category, `%arg` is replaced
+// with the substitution argument if set.
+func xcForms(plural map[string]xcLocalization, arg string) (map[string]string, error) {
+	forms := make(map[string]string, len(plural))
+
+	for category, variation := range plural {
+		if !isCategory(category) {
+			return nil, fmt.Errorf("plural category `%v` is not supported", category)
+		}
+
+		if variation.StringUnit == nil {
+			return nil, fmt.Errorf("nested variations are not supported")
+		}
+
+		forms[category] = variation.StringUnit.Value
+		if arg != "" {
+			forms[category] = strings.ReplaceAll(forms[category], "%arg", arg)
+		}
+	}
+
+	return forms, nil
+}
+
+// Encode messages as Xcode String Catalog with the language as the source
+// language. Plural rules of the first argument are written as plural
+// variations, of another argument as a substitution. `=N` selectors other
+// than `=0` are skipped, a message with `<N` and `>N` selectors can't be
+// exported.
+func encodeXCStrings(w io.Writer, tag language.Tag, m []translationMessage) error {
+	catalog := xcCatalog{
+		SourceLanguage: tag.String(),
+		Strings:        make(map[string]xcString, len(m)),
+		Version:        "1.0",
+	}
+
+	for _, message := range m {
+		var localization xcLocalization
+
+		if message.Message != nil {
+			localization.StringUnit = &xcStringUnit{
+				State: "translated",
+				Value: printfToApple(*message.Message, 0),
+			}
+		} else {
+			arguments := pluralArguments(message.Rules)
+			if len(arguments) == 0 {
+				continue
+			}
+
+			if text, ok := comparisonError(arguments[0]); ok {
+				return &ErrorEncode{Format: FormatXCStrings, MessageID: message.ID, Message: text}
+			}
+
+			localization = xcPlural(arguments[0])
+		}
+
+		catalog.Strings[message.ID] = xcString{
+			Comment:         message.Description,
+			ExtractionState: "manual",
+			Localizations:   map[string]xcLocalization{tag.String(): localization},
+		}
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(catalog)
+}
+
+// Convert the plural argument to plural variations or a substitution.
+func xcPlural(arg pluralArgument) xcLocalization {
+	count := 0
+	if arg.number != 1 {
+		count = arg.number
+	}
+
+	plural := make(map[string]xcLocalization)
+
+	for category, text := range appleForms(arg) {
+		plural[category] = xcLocalization{
+			StringUnit: &xcStringUnit{State: "translated", Value: printfToApple(text, count)},
+		}
+	}
+
+	if count == 0 {
+		return xcLocalization{Variations: &xcVariations{Plural: plural}}
+	}
+
+	name := "arg" + strconv.Itoa(count)
+
+	return xcLocalization{
+		StringUnit: &xcStringUnit{State: "translated", Value: fmt.Sprintf("%%%v$#@%v@", count, name)},
+		Substitutions: map[string]xcSubstitution{
+			name: {
+				ArgNum:          count,
+				FormatSpecifier: appleValueType(arg),
+				Variations:      xcVariations{Plural: plural},
+			},
+		},
+	}
+}
@@ -0,0 +1,262 @@
+package i18n
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+)
+
+// Xcode String Catalog with English and Russian translations.
+const testCatalog = `{
+  "sourceLanguage" : "en",
+  "strings" : {
+    "xcstrings apples" : {
+      "localizations" : {
+        "en" : {
+          "variations" : {
+            "plural" : {
+              "one" : {"stringUnit" : {"state" : "translated", "value" : "%lld apple"}},
+              "other" : {"stringUnit" : {"state" : "translated", "value" : "%lld apples"}},
+              "zero" : {"stringUnit" : {"state" : "translated", "value" : "No apples"}}
+            }
+          }
+        },
+        "ru" : {
+          "variations" : {
+            "plural" : {
+              "one" : {"stringUnit" : {"state" : "translated", "value" : "%lld яблоко"}},
+              "few" : {"stringUnit" : {"state" : "translated", "value" : "%lld яблока"}},
+              "many" : {"stringUnit" : {"state" : "translated", "value" : "%lld яблок"}},
+              "other" : {"stringUnit" : {"state" : "translated", "value" : "%lld яблока"}}
+            }
+          }
+        }
+      }
+    },
+    "xcstrings cart" : {
+      "comment" : "Cart summary",
+      "localizations" : {
+        "en" : {
+          "stringUnit" : {"state" : "translated", "value" : "%@, you have %#@items@"},
+          "substitutions" : {
+            "items" : {
+              "argNum" : 2,
+              "formatSpecifier" : "lld",
+              "variations" : {
+                "plural" : {
+                  "one" : {"stringUnit" : {"state" : "translated", "value" : "%arg item"}},
+                  "other" : {"stringUnit" : {"state" : "translated", "value" : "%arg items"}}
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "xcstrings hello %@" : {
+      "localizations" : {
+        "ru" : {"stringUnit" : {"state" : "translated", "value" : "Привет, %@"}}
+      }
+    }
+  },
+  "version" : "1.0"
+}`
+
+func Test_XCStringsDecode(t *testing.T) {
+	t.Parallel()
+
+	text := func(s string) *string { return &s }
+
+	messages, err := xcstringsDecoder{}.decodeBundle([]byte(testCatalog))
+	assert.NoError(t, err)
+
+	assert.Equal(t, map[language.Tag][]translationMessage{
+		language.English: {
+			{
+				ID:    "xcstrings apples",
+				Rules: map[string]interface{}{"=0": "No apples", "one": "%[1]d apple", "other": "%[1]d apples"},
+			},
+			{
+				ID: "xcstrings cart",
+				Rules: map[string]interface{}{"2": map[string]interface{}{
+					"one":   "%[1]v, you have %[2]d item",
+					"other": "%[1]v, you have %[2]d items",
+				}},
+				Description: "Cart summary",
+			},
+			{ID: "xcstrings hello %@", Message: text("xcstrings hello %v")},
+		},
+		language.Russian: {
+			{
+				ID: "xcstrings apples",
+				Rules: map[string]interface{}{
+					"one":   "%[1]d яблоко",
+					"few":   "%[1]d яблока",
+					"many":  "%[1]d яблок",
+					"other": "%[1]d яблока",
+				},
+			},
+			{ID: "xcstrings hello %@", Message: text("Привет, %v")},
+		},
+	}, messages)
+
+	source, err := xcstringsDecoder{}.decode([]byte(testCatalog))
+	assert.NoError(t, err)
+	assert.Equal(t, messages[language.English], source)
+
+	_, err = xcstringsDecoder{}.decode([]byte(`{
+    "sourceLanguage": "en",
+    "strings": {"hello": {"localizations": {"en": {"variations": {"device": {
+      "iphone": {"stringUnit": {"state": "translated", "value": "Hello"}}
+    }}}}}}
+  }`))
+	assert.Error(t, err)
+}
+
+func Test_XCStringsLoad(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "Localizable.xcstrings")
+	assert.NoError(t, os.WriteFile(path, []byte(testCatalog), 0o600))
+
+	i18n := New(&Config{Path: path, Fallback: language.English})
+	assert.NoError(t, i18n.Load())
+
+	assert.Equal(t, []language.Tag{language.English, language.Russian}, i18n.Tags())
+
+	p := i18n.Printer(language.Russian)
+	assert.Equal(t, "5 яблок", p.Sprintf("xcstrings apples", 5))
+	assert.Equal(t, "Привет, Иван", p.Sprintf("xcstrings hello %@", "Иван"))
+
+	p = i18n.Printer(language.English)
+	assert.Equal(t, "No apples", p.Sprintf("xcstrings apples", 0))
+	assert.Equal(t, "Ivan, you have 1 item", p.Sprintf("xcstrings cart", "Ivan", 1))
+
+	// A catalog in a language folder provides the language of the folder.
+	dir = t.TempDir()
+	assert.NoError(t, os.MkdirAll(filepath.Join(dir, "ru"), 0o755))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "ru", "Localizable.xcstrings"), []byte(testCatalog), 0o600))
+
+	i18n = New(&Config{Path: dir})
+	assert.NoError(t, i18n.Load())
+
+	assert.Equal(t, []string{"xcstrings apples", "xcstrings hello %@"}, i18n.IDs(language.Russian))
+}
+
+func Test_XCStringsEncode(t *testing.T) {
+	t.Parallel()
+
+	text := "Hello, %s"
+
+	m := []translationMessage{
+		{ID: "apples", Rules: map[string]interface{}{"=0": "No apples", "one": "%d apple", "other": "%d apples"}},
+		{ID: "cart", Rules: map[string]interface{}{"2": map[string]interface{}{"one": "%s: %d item", "other": "%s: %d items"}}},
+		{ID: "hello", Message: &text, Description: "Greeting"},
+	}
+
+	var out bytes.Buffer
+
+	assert.NoError(t, encodeXCStrings(&out, language.English, m))
+	assert.Equal(t, `{
+  "sourceLanguage": "en",
+  "strings": {
+    "apples": {
+      "extractionState": "manual",
+      "localizations": {
+        "en": {
+          "variations": {
+            "plural": {
+              "one": {
+                "stringUnit": {
+                  "state": "translated",
+                  "value": "%1$lld apple"
+                }
+              },
+              "other": {
+                "stringUnit": {
+                  "state": "translated",
+                  "value": "%1$lld apples"
+                }
+              },
+              "zero": {
+                "stringUnit": {
+                  "state": "translated",
+                  "value": "No apples"
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "cart": {
+      "extractionState": "manual",
+      "localizations": {
+        "en": {
+          "stringUnit": {
+            "state": "translated",
+            "value": "%2$#@arg2@"
+          },
+          "substitutions": {
+            "arg2": {
+              "argNum": 2,
+              "formatSpecifier": "lld",
+              "variations": {
+                "plural": {
+                  "one": {
+                    "stringUnit": {
+                      "state": "translated",
+                      "value": "%1$@: %arg item"
+                    }
+                  },
+                  "other": {
+                    "stringUnit": {
+                      "state": "translated",
+                      "value": "%1$@: %arg items"
+                    }
+                  }
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "hello": {
+      "comment": "Greeting",
+      "extractionState": "manual",
+      "localizations": {
+        "en": {
+          "stringUnit": {
+            "state": "translated",
+            "value": "Hello, %1$@"
+          }
+        }
+      }
+    }
+  },
+  "version": "1.0"
+}
+`, out.String())
+
+	decoded, err := xcstringsDecoder{}.decode(out.Bytes())
+	assert.NoError(t, err)
+
+	assert.Equal(t, map[string]interface{}{"=0": "No apples", "one": "%[1]d apple", "other": "%[1]d apples"}, decoded[0].Rules)
+	assert.Equal(t, map[string]interface{}{"2": map[string]interface{}{
+		"one":   "%[1]v: %[2]d item",
+		"other": "%[1]v: %[2]d items",
+	}}, decoded[1].Rules)
+	assert.Equal(t, "Hello, %v", *decoded[2].Message)
+
+	err = encodeXCStrings(&out, language.English, []translationMessage{{
+		ID:    "few apples",
+		Rules: map[string]interface{}{"<5": "A few apples", "other": "%d apples"},
+	}})
+	assert.IsType(t, &ErrorEncode{}, err)
+}
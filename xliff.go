@@ -0,0 +1,203 @@
+package i18n
+
+import (
+	"encoding/xml"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/language"
+)
+
+// XLIFF 2.0 document.
+type xliffDocument struct {
+	XMLName xml.Name  `xml:"urn:oasis:names:tc:xliff:document:2.0 xliff"`
+	Version string    `xml:"version,attr"`
+	SrcLang string    `xml:"srcLang,attr"`
+	File    xliffFile `xml:"file"`
+}
+
+// XLIFF file, units and groups in order of messages.
+type xliffFile struct {
+	ID    string        `xml:"id,attr"`
+	Items []interface{} `xml:",any"`
+}
+
+// XLIFF group, plural forms of a message.
+type xliffGroup struct {
+	XMLName xml.Name    `xml:"group"`
+	ID      string      `xml:"id,attr"`
+	Name    string      `xml:"name,attr,omitempty"`
+	Notes   *xliffNotes `xml:"notes,omitempty"`
+	Units   []xliffUnit `xml:"unit"`
+}
+
+// XLIFF unit, a message or a plural form.
+type xliffUnit struct {
+	XMLName xml.Name     `xml:"unit"`
+	ID      string       `xml:"id,attr"`
+	Name    string       `xml:"name,attr,omitempty"`
+	Notes   *xliffNotes  `xml:"notes,omitempty"`
+	Segment xliffSegment `xml:"segment"`
+}
+
+// XLIFF notes.
+type xliffNotes struct {
+	Notes []xliffNote `xml:"note"`
+}
+
+// XLIFF note.
+type xliffNote struct {
+	Category string `xml:"category,attr"`
+	Text     string `xml:",chardata"`
+}
+
+// XLIFF segment.
+type xliffSegment struct {
+	Source string `xml:"source"`
+}
+
+// Encode messages as XLIFF 2.0 file. Metadata is written as notes. A
+// message id which is not a valid XLIFF id is converted, the original id is
+// written as the `name` attribute. Plural rules are written as a group with
+// a unit for each plural form, named `<argument>:<selector>`.
+func encodeXLIFF(w io.Writer, tag language.Tag, m []translationMessage) error {
+	document := xliffDocument{
+		Version: "2.0",
+		SrcLang: tag.String(),
+		File:    xliffFile{ID: "messages"},
+	}
+
+	ids := make(xliffIDs)
+
+	for _, message := range m {
+		if isNmtoken(message.ID) {
+			ids[message.ID] = true
+		}
+	}
+
+	for _, message := range m {
+		id, name := message.ID, ""
+		if !isNmtoken(id) {
+			id, name = ids.add(id), id
+		}
+
+		if message.Message != nil {
+			document.File.Items = append(document.File.Items, xliffUnit{
+				ID:      id,
+				Name:    name,
+				Notes:   xliffMessageNotes(message),
+				Segment: xliffSegment{Source: *message.Message},
+			})
+
+			continue
+		}
+
+		group := xliffGroup{ID: id, Name: name, Notes: xliffMessageNotes(message)}
+
+		for _, arg := range pluralArguments(message.Rules) {
+			for _, selector := range arg.selectors {
+				form := strconv.Itoa(arg.number) + "_" + selectorReplacer.Replace(selector)
+
+				group.Units = append(group.Units, xliffUnit{
+					ID:      ids.add(id + "_" + form),
+					Name:    strconv.Itoa(arg.number) + ":" + selector,
+					Segment: xliffSegment{Source: arg.messages[selector]},
+				})
+			}
+		}
+
+		document.File.Items = append(document.File.Items, group)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+
+	if err := encoder.Encode(document); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, "\n")
+
+	return err
+}
+
+// Replacer of plural selector characters which are not allowed in XLIFF ids.
+var selectorReplacer = strings.NewReplacer("=", "eq", "<", "lt", ">", "gt")
+
+// Used XLIFF ids.
+type xliffIDs map[string]bool
+
+// Add an id for the value: characters which are not allowed are replaced
+// with `_` and a number is appended if the id is used.
+func (ids xliffIDs) add(s string) string {
+	base := strings.Map(func(r rune) rune {
+		if isNameRune(r) {
+			return r
+		}
+
+		return '_'
+	}, s)
+
+	if base == "" {
+		base = "_"
+	}
+
+	id := base
+
+	for n := 2; ids[id]; n++ {
+		id = base + "_" + strconv.Itoa(n)
+	}
+
+	ids[id] = true
+
+	return id
+}
+
+// Check if the string is an XML NMTOKEN, the type of XLIFF ids.
+func isNmtoken(s string) bool {
+	for _, r := range s {
+		if !isNameRune(r) {
+			return false
+		}
+	}
+
+	return s != ""
+}
+
+// Check if the rune is allowed in XML names.
+func isNameRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.Is(unicode.M, r) || strings.ContainsRune(".-_:", r)
+}
+
+// Get notes with the message metadata.
+func xliffMessageNotes(message translationMessage) *xliffNotes {
+	var notes []xliffNote
+
+	if message.Description != "" {
+		notes = append(notes, xliffNote{Category: "description", Text: message.Description})
+	}
+
+	if message.Context != "" {
+		notes = append(notes, xliffNote{Category: "context", Text: message.Context})
+	}
+
+	if message.MaxLength > 0 {
+		notes = append(notes, xliffNote{Category: "maxLength", Text: strconv.Itoa(message.MaxLength)})
+	}
+
+	if message.Hash != "" {
+		notes = append(notes, xliffNote{Category: "hash", Text: message.Hash})
+	}
+
+	if len(notes) == 0 {
+		return nil
+	}
+
+	return &xliffNotes{Notes: notes}
+}
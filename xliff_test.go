@@ -0,0 +1,77 @@
+package i18n
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+)
+
+func Test_EncodeXLIFF(t *testing.T) {
+	t.Parallel()
+
+	text := "Войти"
+	hello := "Привет, мир!"
+
+	m := []translationMessage{
+		{
+			ID:          "login",
+			Message:     &text,
+			Description: "Login button",
+			Context:     "header",
+			MaxLength:   10,
+		},
+		{
+			ID:    "apples",
+			Rules: map[string]interface{}{"one": "%d яблоко", "=0": "нет яблок"},
+		},
+		{ID: "Hello, world!", Message: &hello, Description: "Greeting"},
+		{ID: "Hello__world_", Message: &hello},
+	}
+
+	var out bytes.Buffer
+
+	assert.NoError(t, encodeXLIFF(&out, language.Russian, m))
+	assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<xliff xmlns="urn:oasis:names:tc:xliff:document:2.0" version="2.0" srcLang="ru">
+  <file id="messages">
+    <unit id="login">
+      <notes>
+        <note category="description">Login button</note>
+        <note category="context">header</note>
+        <note category="maxLength">10</note>
+      </notes>
+      <segment>
+        <source>Войти</source>
+      </segment>
+    </unit>
+    <group id="apples">
+      <unit id="apples_1_eq0" name="1:=0">
+        <segment>
+          <source>нет яблок</source>
+        </segment>
+      </unit>
+      <unit id="apples_1_one" name="1:one">
+        <segment>
+          <source>%d яблоко</source>
+        </segment>
+      </unit>
+    </group>
+    <unit id="Hello__world__2" name="Hello, world!">
+      <notes>
+        <note category="description">Greeting</note>
+      </notes>
+      <segment>
+        <source>Привет, мир!</source>
+      </segment>
+    </unit>
+    <unit id="Hello__world_">
+      <segment>
+        <source>Привет, мир!</source>
+      </segment>
+    </unit>
+  </file>
+</xliff>
+`, out.String())
+}